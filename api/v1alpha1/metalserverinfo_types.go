@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MetalServerInfoStatus describes the server that backs a Node. It is copied from the claimed Server
+// and its BMC in the metal cluster without credentials and management addresses.
+type MetalServerInfoStatus struct {
+	// ServerClaim is the namespaced name of the ServerClaim of the Node in the metal cluster.
+	// +optional
+	ServerClaim string `json:"serverClaim,omitempty"`
+
+	// Server is the name of the Server that is bound to the ServerClaim.
+	// +optional
+	Server string `json:"server,omitempty"`
+
+	// SystemUUID is the UUID of the server.
+	// +optional
+	SystemUUID string `json:"systemUUID,omitempty"`
+
+	// Manufacturer is the manufacturer of the server.
+	// +optional
+	Manufacturer string `json:"manufacturer,omitempty"`
+
+	// Model is the model of the server.
+	// +optional
+	Model string `json:"model,omitempty"`
+
+	// SKU is the stock keeping unit of the server.
+	// +optional
+	SKU string `json:"sku,omitempty"`
+
+	// SerialNumber is the serial number of the server.
+	// +optional
+	SerialNumber string `json:"serialNumber,omitempty"`
+
+	// BIOSVersion is the version of the BIOS of the server.
+	// +optional
+	BIOSVersion string `json:"biosVersion,omitempty"`
+
+	// PowerState is the power state of the server.
+	// +optional
+	PowerState string `json:"powerState,omitempty"`
+
+	// State is the state of the server in the metal cluster.
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// TotalSystemMemory is the total amount of memory of the server.
+	// +optional
+	TotalSystemMemory *resource.Quantity `json:"totalSystemMemory,omitempty"`
+
+	// Processors are the processors of the server.
+	// +optional
+	Processors []ProcessorInfo `json:"processors,omitempty"`
+
+	// NetworkInterfaces are the network interfaces of the server.
+	// +optional
+	NetworkInterfaces []NetworkInterfaceInfo `json:"networkInterfaces,omitempty"`
+
+	// BMC describes the baseboard management controller of the server.
+	// +optional
+	BMC *BMCInfo `json:"bmc,omitempty"`
+
+	// LastSyncTime is the time the information was last copied from the metal cluster.
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// ProcessorInfo describes a processor of a server.
+type ProcessorInfo struct {
+	// ID is the identifier of the processor.
+	ID string `json:"id"`
+	// Type is the type of the processor.
+	// +optional
+	Type string `json:"type,omitempty"`
+	// Architecture is the architecture of the processor.
+	// +optional
+	Architecture string `json:"architecture,omitempty"`
+	// Manufacturer is the manufacturer of the processor.
+	// +optional
+	Manufacturer string `json:"manufacturer,omitempty"`
+	// Model is the model of the processor.
+	// +optional
+	Model string `json:"model,omitempty"`
+	// MaxSpeedMHz is the maximum speed of the processor in MHz.
+	// +optional
+	MaxSpeedMHz int32 `json:"maxSpeedMHz,omitempty"`
+	// TotalCores is the number of cores of the processor.
+	// +optional
+	TotalCores int32 `json:"totalCores,omitempty"`
+	// TotalThreads is the number of threads of the processor.
+	// +optional
+	TotalThreads int32 `json:"totalThreads,omitempty"`
+}
+
+// NetworkInterfaceInfo describes a network interface of a server.
+type NetworkInterfaceInfo struct {
+	// Name is the name of the network interface.
+	Name string `json:"name"`
+	// MACAddress is the MAC address of the network interface.
+	// +optional
+	MACAddress string `json:"macAddress,omitempty"`
+	// IPs are the IP addresses of the network interface.
+	// +optional
+	IPs []string `json:"ips,omitempty"`
+	// CarrierStatus is the carrier status of the network interface.
+	// +optional
+	CarrierStatus string `json:"carrierStatus,omitempty"`
+}
+
+// BMCInfo describes the baseboard management controller of a server.
+type BMCInfo struct {
+	// Manufacturer is the manufacturer of the BMC.
+	// +optional
+	Manufacturer string `json:"manufacturer,omitempty"`
+	// Model is the model of the BMC.
+	// +optional
+	Model string `json:"model,omitempty"`
+	// SerialNumber is the serial number of the BMC.
+	// +optional
+	SerialNumber string `json:"serialNumber,omitempty"`
+	// FirmwareVersion is the firmware version of the BMC.
+	// +optional
+	FirmwareVersion string `json:"firmwareVersion,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=msi
+// +kubebuilder:printcolumn:name="Server",type="string",JSONPath=".status.server"
+// +kubebuilder:printcolumn:name="Manufacturer",type="string",JSONPath=".status.manufacturer"
+// +kubebuilder:printcolumn:name="Model",type="string",JSONPath=".status.model"
+// +kubebuilder:printcolumn:name="SerialNumber",type="string",JSONPath=".status.serialNumber"
+// +kubebuilder:printcolumn:name="BMCFirmware",type="string",JSONPath=".status.bmc.firmwareVersion",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// MetalServerInfo is a read-only description of the server that backs the Node of the same name.
+type MetalServerInfo struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status MetalServerInfoStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MetalServerInfoList contains a list of MetalServerInfo.
+type MetalServerInfoList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MetalServerInfo `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MetalServerInfo{}, &MetalServerInfoList{})
+}
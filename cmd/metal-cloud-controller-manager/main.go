@@ -9,16 +9,18 @@ import (
 
 	"github.com/ironcore-dev/cloud-provider-metal/pkg/cloudprovider/metal"
 	"github.com/ironcore-dev/cloud-provider-metal/pkg/version"
+	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/util/wait"
 	cloudprovider "k8s.io/cloud-provider"
 	"k8s.io/cloud-provider/app"
 	cloudcontrollerconfig "k8s.io/cloud-provider/app/config"
-	"k8s.io/cloud-provider/names"
 	"k8s.io/cloud-provider/options"
 	cliflag "k8s.io/component-base/cli/flag"
 	"k8s.io/component-base/logs"
 	_ "k8s.io/component-base/metrics/prometheus/clientgo"
 	_ "k8s.io/component-base/metrics/prometheus/version"
+	_ "k8s.io/component-base/metrics/prometheus/workqueue"
+	"k8s.io/component-base/term"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
@@ -38,12 +40,32 @@ func main() {
 	fss := cliflag.NamedFlagSets{}
 	metal.AddExtraFlags(fss.FlagSet("Metal Client"))
 
-	command := app.NewCloudControllerManagerCommand(ccmOptions,
-		cloudInitializer,
-		app.DefaultInitFuncConstructors,
-		names.CCMControllerAliases(),
-		fss,
-		wait.NeverStop)
+	builder := app.NewBuilder()
+	builder.SetOptions(ccmOptions)
+	builder.AddFlags(fss)
+	builder.RegisterDefaultControllers()
+	builder.RegisterWebhook(metal.NodeAdmissionWebhookName, app.WebhookConfig{
+		Path:             metal.NodeAdmissionWebhookPath,
+		AdmissionHandler: metal.ValidateNodeAdmission,
+	})
+	builder.SetCloudInitializer(cloudInitializer)
+	builder.SetStopChannel(wait.NeverStop)
+	command := builder.BuildCommand()
+	// The builder only prints the flags of the cloud controller manager, not the additional ones.
+	help, usage := command.HelpFunc(), command.UsageFunc()
+	cols, _, _ := term.TerminalSize(command.OutOrStdout())
+	command.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+		help(cmd, args)
+		cliflag.PrintSections(cmd.OutOrStdout(), fss, cols)
+	})
+	command.SetUsageFunc(func(cmd *cobra.Command) error {
+		if err := usage(cmd); err != nil {
+			return err
+		}
+		cliflag.PrintSections(cmd.OutOrStderr(), fss, cols)
+		return nil
+	})
+	command.AddCommand(newMaintenanceHistoryCommand())
 
 	klog.V(1).InfoS("metal-cloud-controller-manager version", "version", version.Version)
 
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ironcore-dev/cloud-provider-metal/pkg/cloudprovider/metal"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// newMaintenanceHistoryCommand returns the command that prints the maintenance history recorded by
+// the cloud controller manager.
+func newMaintenanceHistoryCommand() *cobra.Command {
+	var kubeconfig, namespace, output string
+	cmd := &cobra.Command{
+		Use:   "maintenance-history [NODE...]",
+		Short: "Print the server maintenance history of Nodes",
+		Long: "Print the server maintenance history that is recorded in the target cluster if the " +
+			"maintenanceHistory of the cloud config is enabled. Without arguments the history of all Nodes is printed.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if output != "table" && output != "json" {
+				return fmt.Errorf("unknown output format %q, must be table or json", output)
+			}
+
+			loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+			loadingRules.ExplicitPath = kubeconfig
+			restConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, nil).ClientConfig()
+			if err != nil {
+				return fmt.Errorf("unable to load kubeconfig: %w", err)
+			}
+			c, err := client.New(restConfig, client.Options{})
+			if err != nil {
+				return fmt.Errorf("unable to create client: %w", err)
+			}
+
+			history, err := metal.GetMaintenanceHistory(cmd.Context(), c, namespace)
+			if err != nil {
+				return err
+			}
+			if len(args) > 0 {
+				for nodeName := range history {
+					if !slices.Contains(args, nodeName) {
+						delete(history, nodeName)
+					}
+				}
+			}
+
+			if output == "json" {
+				encoder := json.NewEncoder(cmd.OutOrStdout())
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(history)
+			}
+
+			nodeNames := make([]string, 0, len(history))
+			for nodeName := range history {
+				nodeNames = append(nodeNames, nodeName)
+			}
+			slices.Sort(nodeNames)
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+			_, _ = fmt.Fprintln(w, "NODE\tSERVER\tREQUESTED\tAPPROVED\tAPPROVED BY\tSTARTED\tCOMPLETED\tOUTCOME\tREASON")
+			for _, nodeName := range nodeNames {
+				for _, record := range history[nodeName] {
+					_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", nodeName, orNone(record.Server),
+						formatTime(&record.RequestedAt), formatTime(record.ApprovedAt), orNone(record.ApprovedBy),
+						formatTime(record.StartedAt), formatTime(record.CompletedAt), orNone(string(record.Outcome)), orNone(record.Reason))
+				}
+			}
+			return w.Flush()
+		},
+	}
+	// The cloud controller manager command prints its own flags as usage, which does not apply here.
+	defaults := &cobra.Command{}
+	cmd.SetHelpFunc(defaults.HelpFunc())
+	cmd.SetUsageFunc(defaults.UsageFunc())
+	cmd.Flags().StringVar(&kubeconfig, "kubeconfig", "", "Path to the kubeconfig of the target cluster. Defaults to the KUBECONFIG environment variable.")
+	cmd.Flags().StringVar(&namespace, "namespace", metal.DefaultMaintenanceHistoryNamespace, "Namespace of the maintenance history.")
+	cmd.Flags().StringVarP(&output, "output", "o", "table", "Output format, table or json.")
+	return cmd
+}
+
+func formatTime(t *metav1.Time) string {
+	if t == nil || t.IsZero() {
+		return "<none>"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+	return s
+}
@@ -0,0 +1,341 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	eventReasonBIOSUpdateRequested = "BIOSUpdateRequested"
+	eventReasonBIOSUpdateCompleted = "BIOSUpdateCompleted"
+	eventReasonBIOSUpdateFailed    = "BIOSUpdateFailed"
+	eventReasonInvalidBIOSUpdate   = "InvalidBIOSUpdate"
+
+	eventActionBIOS = "BIOS"
+
+	conditionReasonInvalid  = "Invalid"
+	conditionReasonConflict = "Conflict"
+
+	// biosSettingsFlowPriority is the priority of the settings requested on a Node in the BIOSSettings flow.
+	biosSettingsFlowPriority = int32(1)
+)
+
+// errBIOSConflict is returned if the BIOS object of a server is not managed for the ServerClaim of the Node.
+var errBIOSConflict = errors.New("BIOS object is not managed for the ServerClaim")
+
+// biosUpdate is the state of a BIOSSettings or BIOSVersion object reported on a Node.
+type biosUpdate struct {
+	conditionType corev1.NodeConditionType
+	object        client.Object
+	kind          string
+	state         string
+	done          bool
+	failed        bool
+	created       bool
+}
+
+// reconcileBIOS turns the BIOS annotations of the Node into BIOSSettings and BIOSVersion objects for
+// its server and reports their state as Node conditions. The objects require the approval of their
+// ServerMaintenance, so they are only applied once the maintenance handshake of the Node approves it.
+func (r *NodeReconciler) reconcileBIOS(ctx context.Context, node *corev1.Node) error {
+	if !r.cloudConfig.BIOSUpdates.Enabled {
+		return nil
+	}
+	serverClaimKey, err := getObjectKeyFromProviderID(node.Spec.ProviderID)
+	if err != nil {
+		// The invalid providerID is already reported by reconcileMaintenance.
+		return nil
+	}
+
+	serverClaim := &metalv1alpha1.ServerClaim{}
+	if err := r.metalClient.Get(ctx, serverClaimKey, serverClaim); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("unable to get ServerClaim: %w", err)
+	}
+	if serverClaimOwnedByOtherCluster(serverClaim, r.cloudConfig.ClusterName) || serverClaim.Spec.ServerRef == nil ||
+		hasServerMismatchTaint(node) {
+		return nil
+	}
+	serverName := serverClaim.Spec.ServerRef.Name
+
+	if err := r.reconcileBIOSSettings(ctx, node, serverClaimKey, serverName); err != nil {
+		return err
+	}
+	return r.reconcileBIOSVersion(ctx, node, serverClaimKey, serverName)
+}
+
+func (r *NodeReconciler) reconcileBIOSSettings(ctx context.Context, node *corev1.Node, serverClaimKey types.NamespacedName, serverName string) error {
+	settings := &metalv1alpha1.BIOSSettings{}
+	settings.Name = serverName
+
+	value, requested := node.Annotations[AnnotationBIOSSettings]
+	if !requested {
+		if removed, err := r.ensureBIOSObjectNotExists(ctx, settings, serverClaimKey); err != nil || !removed {
+			return err
+		}
+		return removeNodeCondition(ctx, r.targetClient, node, NodeConditionBIOSSettings)
+	}
+
+	var values map[string]string
+	if err := json.Unmarshal([]byte(value), &values); err != nil || len(values) == 0 {
+		return r.reportInvalidBIOSUpdate(ctx, node, NodeConditionBIOSSettings,
+			fmt.Sprintf("annotation %s must be a non-empty JSON object of string values", AnnotationBIOSSettings))
+	}
+	version := node.Annotations[AnnotationBIOSSettingsVersion]
+	if version == "" {
+		server := &metalv1alpha1.Server{}
+		if err := r.metalClient.Get(ctx, client.ObjectKey{Name: serverName}, server); err != nil {
+			return fmt.Errorf("unable to get Server: %w", err)
+		}
+		version = server.Status.BIOSVersion
+	}
+	if version == "" {
+		return r.reportInvalidBIOSUpdate(ctx, node, NodeConditionBIOSSettings,
+			fmt.Sprintf("the BIOS version of Server %s is unknown, annotation %s is required", serverName, AnnotationBIOSSettingsVersion))
+	}
+
+	result, err := controllerutil.CreateOrPatch(ctx, r.metalClient, settings, func() error {
+		if !settings.CreationTimestamp.IsZero() && !biosObjectManagedFor(settings, serverClaimKey) {
+			return errBIOSConflict
+		}
+		setBIOSObjectLabels(settings, serverClaimKey)
+		if settings.Spec.ServerRef == nil {
+			settings.Spec.ServerRef = &corev1.LocalObjectReference{Name: serverName}
+		}
+		settings.Spec.Version = version
+		settings.Spec.SettingsFlow = []metalv1alpha1.SettingsFlowItem{{
+			Name:     cloudProviderMetalName,
+			Settings: values,
+			Priority: biosSettingsFlowPriority,
+		}}
+		settings.Spec.ServerMaintenancePolicy = metalv1alpha1.ServerMaintenancePolicyOwnerApproval
+		return nil
+	})
+	if err != nil {
+		return r.handleBIOSObjectError(ctx, node, NodeConditionBIOSSettings, settings, "BIOSSettings", err)
+	}
+
+	state := settings.Status.State
+	if state == "" || settings.Status.ObservedGeneration != settings.Generation {
+		state = metalv1alpha1.BIOSSettingsStatePending
+	}
+	return r.reportBIOSUpdate(ctx, node, biosUpdate{
+		conditionType: NodeConditionBIOSSettings,
+		object:        settings,
+		kind:          "BIOSSettings",
+		state:         string(state),
+		done:          state == metalv1alpha1.BIOSSettingsStateApplied,
+		failed:        state == metalv1alpha1.BIOSSettingsStateFailed,
+		created:       result == controllerutil.OperationResultCreated,
+	})
+}
+
+func (r *NodeReconciler) reconcileBIOSVersion(ctx context.Context, node *corev1.Node, serverClaimKey types.NamespacedName, serverName string) error {
+	biosVersion := &metalv1alpha1.BIOSVersion{}
+	biosVersion.Name = serverName
+
+	version, requested := node.Annotations[AnnotationBIOSVersion]
+	if !requested {
+		if removed, err := r.ensureBIOSObjectNotExists(ctx, biosVersion, serverClaimKey); err != nil || !removed {
+			return err
+		}
+		return removeNodeCondition(ctx, r.targetClient, node, NodeConditionBIOSVersion)
+	}
+
+	image := node.Annotations[AnnotationBIOSVersionImage]
+	if version == "" || image == "" {
+		return r.reportInvalidBIOSUpdate(ctx, node, NodeConditionBIOSVersion,
+			fmt.Sprintf("annotations %s and %s must not be empty", AnnotationBIOSVersion, AnnotationBIOSVersionImage))
+	}
+
+	result, err := controllerutil.CreateOrPatch(ctx, r.metalClient, biosVersion, func() error {
+		if !biosVersion.CreationTimestamp.IsZero() && !biosObjectManagedFor(biosVersion, serverClaimKey) {
+			return errBIOSConflict
+		}
+		setBIOSObjectLabels(biosVersion, serverClaimKey)
+		if biosVersion.Spec.ServerRef == nil {
+			biosVersion.Spec.ServerRef = &corev1.LocalObjectReference{Name: serverName}
+		}
+		biosVersion.Spec.Version = version
+		biosVersion.Spec.Image.URI = image
+		biosVersion.Spec.ServerMaintenancePolicy = metalv1alpha1.ServerMaintenancePolicyOwnerApproval
+		return nil
+	})
+	if err != nil {
+		return r.handleBIOSObjectError(ctx, node, NodeConditionBIOSVersion, biosVersion, "BIOSVersion", err)
+	}
+
+	state := biosVersion.Status.State
+	if state == "" || biosVersion.Status.ObservedGeneration != biosVersion.Generation {
+		state = metalv1alpha1.BIOSVersionStatePending
+	}
+	return r.reportBIOSUpdate(ctx, node, biosUpdate{
+		conditionType: NodeConditionBIOSVersion,
+		object:        biosVersion,
+		kind:          "BIOSVersion",
+		state:         string(state),
+		done:          state == metalv1alpha1.BIOSVersionStateCompleted,
+		failed:        state == metalv1alpha1.BIOSVersionStateFailed,
+		created:       result == controllerutil.OperationResultCreated,
+	})
+}
+
+// handleBIOSObjectError reports a BIOS object of the server that is not managed for the Node as a
+// conflict. An object that already exists is picked up again once the cache catches up.
+func (r *NodeReconciler) handleBIOSObjectError(ctx context.Context, node *corev1.Node, conditionType corev1.NodeConditionType, obj client.Object, kind string, err error) error {
+	switch {
+	case errors.Is(err, errBIOSConflict):
+		changed, patchErr := patchNodeConditions(ctx, r.targetClient, node, corev1.NodeCondition{
+			Type:    conditionType,
+			Status:  corev1.ConditionFalse,
+			Reason:  conditionReasonConflict,
+			Message: fmt.Sprintf("%s %s is not managed for this Node", kind, obj.GetName()),
+		})
+		if patchErr != nil {
+			return patchErr
+		}
+		if changed {
+			r.recorder.Eventf(node, nil, corev1.EventTypeWarning, eventReasonInvalidBIOSUpdate, eventActionBIOS,
+				"%s %s of the server is not managed for this Node", kind, obj.GetName())
+		}
+		return nil
+	case apierrors.IsAlreadyExists(err):
+		return nil
+	default:
+		return fmt.Errorf("unable to apply %s %s: %w", kind, obj.GetName(), err)
+	}
+}
+
+// reportInvalidBIOSUpdate reports malformed BIOS annotations of the Node. The BIOS object of the
+// server is left as it is until the annotations are fixed.
+func (r *NodeReconciler) reportInvalidBIOSUpdate(ctx context.Context, node *corev1.Node, conditionType corev1.NodeConditionType, problem string) error {
+	changed, err := patchNodeConditions(ctx, r.targetClient, node, corev1.NodeCondition{
+		Type:    conditionType,
+		Status:  corev1.ConditionFalse,
+		Reason:  conditionReasonInvalid,
+		Message: problem,
+	})
+	if err != nil {
+		return err
+	}
+	if changed {
+		r.recorder.Eventf(node, nil, corev1.EventTypeWarning, eventReasonInvalidBIOSUpdate, eventActionBIOS,
+			"Ignoring BIOS update, %s", problem)
+	}
+	return nil
+}
+
+// reportBIOSUpdate sets the condition of the BIOS update and emits an Event when it was requested,
+// completed or failed.
+func (r *NodeReconciler) reportBIOSUpdate(ctx context.Context, node *corev1.Node, update biosUpdate) error {
+	previousState := ""
+	if condition := getNodeCondition(node, update.conditionType); condition != nil {
+		previousState = condition.Reason
+	}
+	status := corev1.ConditionFalse
+	if update.done {
+		status = corev1.ConditionTrue
+	}
+	if _, err := patchNodeConditions(ctx, r.targetClient, node, corev1.NodeCondition{
+		Type:    update.conditionType,
+		Status:  status,
+		Reason:  update.state,
+		Message: fmt.Sprintf("%s %s is %s", update.kind, update.object.GetName(), update.state),
+	}); err != nil {
+		return err
+	}
+
+	name := update.object.GetName()
+	switch {
+	case update.created:
+		klog.InfoS("Requested BIOS update", "node", node.Name, "kind", update.kind, "name", name)
+		r.recorder.Eventf(node, nil, corev1.EventTypeNormal, eventReasonBIOSUpdateRequested, eventActionBIOS,
+			"Created %s %s, it is applied once the server maintenance is approved", update.kind, name)
+	case previousState == update.state:
+	case update.done:
+		klog.InfoS("Completed BIOS update", "node", node.Name, "kind", update.kind, "name", name)
+		r.recorder.Eventf(node, nil, corev1.EventTypeNormal, eventReasonBIOSUpdateCompleted, eventActionBIOS,
+			"%s %s is %s", update.kind, name, update.state)
+	case update.failed:
+		klog.InfoS("BIOS update failed", "node", node.Name, "kind", update.kind, "name", name)
+		r.recorder.Eventf(node, nil, corev1.EventTypeWarning, eventReasonBIOSUpdateFailed, eventActionBIOS,
+			"%s %s failed", update.kind, name)
+	}
+	return nil
+}
+
+// ensureBIOSObjectNotExists deletes the BIOS object of the server if it is managed for the ServerClaim
+// and reports whether it is removed. An update that is in progress is not interrupted, the object is
+// deleted once its state changes.
+func (r *NodeReconciler) ensureBIOSObjectNotExists(ctx context.Context, obj client.Object, serverClaimKey types.NamespacedName) (bool, error) {
+	if err := r.metalClient.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		return true, client.IgnoreNotFound(err)
+	}
+	if !biosObjectManagedFor(obj, serverClaimKey) {
+		return true, nil
+	}
+	if biosUpdateInProgress(obj) {
+		klog.V(2).InfoS("Postponing deletion of BIOS object of withdrawn update in progress", "name", obj.GetName(), "serverclaim", serverClaimKey)
+		return false, nil
+	}
+	klog.InfoS("Deleting BIOS object of withdrawn update", "name", obj.GetName(), "serverclaim", serverClaimKey)
+	if err := r.metalClient.Delete(ctx, obj); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	return true, nil
+}
+
+// biosUpdateInProgress reports whether the BIOS object is being applied to the server.
+func biosUpdateInProgress(obj client.Object) bool {
+	switch o := obj.(type) {
+	case *metalv1alpha1.BIOSSettings:
+		return o.Status.State == metalv1alpha1.BIOSSettingsStateInProgress
+	case *metalv1alpha1.BIOSVersion:
+		return o.Status.State == metalv1alpha1.BIOSVersionStateInProgress
+	default:
+		return false
+	}
+}
+
+// biosObjectManagedFor reports whether the BIOS object was created for the ServerClaim.
+func biosObjectManagedFor(obj client.Object, serverClaimKey types.NamespacedName) bool {
+	labels := obj.GetLabels()
+	return labels[labelKeyManagedBy] == cloudProviderMetalName &&
+		labels[LabelKeyServerClaimNamespace] == serverClaimKey.Namespace &&
+		labels[LabelKeyServerClaimName] == serverClaimKey.Name
+}
+
+func setBIOSObjectLabels(obj client.Object, serverClaimKey types.NamespacedName) {
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels[labelKeyManagedBy] = cloudProviderMetalName
+	labels[LabelKeyServerClaimNamespace] = serverClaimKey.Namespace
+	labels[LabelKeyServerClaimName] = serverClaimKey.Name
+	obj.SetLabels(labels)
+}
+
+// biosServerClaimKey returns the ServerClaim a managed BIOS object was created for.
+func biosServerClaimKey(obj client.Object) (types.NamespacedName, bool) {
+	labels := obj.GetLabels()
+	if labels[labelKeyManagedBy] != cloudProviderMetalName || labels[LabelKeyServerClaimName] == "" {
+		return types.NamespacedName{}, false
+	}
+	return types.NamespacedName{Namespace: labels[LabelKeyServerClaimNamespace], Name: labels[LabelKeyServerClaimName]}, true
+}
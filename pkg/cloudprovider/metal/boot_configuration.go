@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	eventReasonBootConfigurationReady  = "BootConfigurationReady"
+	eventReasonBootConfigurationFailed = "BootConfigurationFailed"
+
+	eventActionBoot = "Boot"
+
+	conditionReasonNotFound = "NotFound"
+)
+
+// reconcileBootConfiguration reports the state of the ServerBootConfiguration of the ServerClaim in
+// the BootConfiguration condition of its Node.
+func (r *ServerClaimBindingReconciler) reconcileBootConfiguration(ctx context.Context, node *corev1.Node, serverClaim *metalv1alpha1.ServerClaim) error {
+	if !r.bootDiagnostics.Enabled || serverClaim.Spec.ServerRef == nil {
+		return nil
+	}
+	config, err := r.getBootConfiguration(ctx, serverClaim)
+	if err != nil {
+		return err
+	}
+
+	previousReason := ""
+	if previous := getNodeCondition(node, NodeConditionBootConfiguration); previous != nil {
+		previousReason = previous.Reason
+	}
+	condition := bootConfigurationCondition(serverClaim, config)
+	if _, err := patchNodeConditions(ctx, r.targetClient, node, condition); err != nil {
+		return err
+	}
+	if previousReason == condition.Reason {
+		return nil
+	}
+
+	switch condition.Reason {
+	case string(metalv1alpha1.ServerBootConfigurationStateReady):
+		// A Node that registers with a ready boot configuration is the normal case and not worth an Event.
+		if previousReason == "" {
+			return nil
+		}
+		r.recorder.Eventf(node, nil, corev1.EventTypeNormal, eventReasonBootConfigurationReady, eventActionBoot,
+			"ServerBootConfiguration %s is ready", config.Name)
+	case string(metalv1alpha1.ServerBootConfigurationStateError):
+		klog.InfoS("Boot configuration of Node failed", "Node", node.Name, "ServerClaim", client.ObjectKeyFromObject(serverClaim))
+		r.recorder.Eventf(node, nil, corev1.EventTypeWarning, eventReasonBootConfigurationFailed, eventActionBoot,
+			"ServerBootConfiguration %s failed: %s", config.Name, bootConfigurationProblems(config))
+	}
+	return nil
+}
+
+// reconcileRegistrationDiagnostic annotates a bound ServerClaim of this cluster whose Node did not
+// register within the registration timeout with the state of its boot configuration.
+func (r *ServerClaimBindingReconciler) reconcileRegistrationDiagnostic(ctx context.Context, serverClaim *metalv1alpha1.ServerClaim) (ctrl.Result, error) {
+	if !r.bootDiagnostics.Enabled || serverClaim.Spec.ServerRef == nil {
+		return ctrl.Result{}, nil
+	}
+	diagnosed, err := r.diagnosesServerClaim(serverClaim)
+	if err != nil || !diagnosed {
+		return ctrl.Result{}, err
+	}
+	config, err := r.getBootConfiguration(ctx, serverClaim)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	claimed := serverClaim.CreationTimestamp.Time
+	if config != nil {
+		claimed = config.CreationTimestamp.Time
+	}
+	timeout := r.bootDiagnostics.GetRegistrationTimeout()
+	if remaining := time.Until(claimed.Add(timeout)); remaining > 0 {
+		return ctrl.Result{RequeueAfter: remaining}, nil
+	}
+
+	diagnostic := fmt.Sprintf("No Node registered within %s after server %s was claimed: %s",
+		timeout, serverClaim.Spec.ServerRef.Name, bootConfigurationCondition(serverClaim, config).Message)
+	return ctrl.Result{}, r.setRegistrationDiagnostic(ctx, serverClaim, diagnostic)
+}
+
+// diagnosesServerClaim reports whether the ServerClaim is known to belong to this cluster. ServerClaims
+// labelled with a cluster name belong to that cluster. Others belong to this cluster if they match the
+// configured ServerClaimSelector or, if none is configured, by being in the metal namespace of the cluster.
+func (r *ServerClaimBindingReconciler) diagnosesServerClaim(serverClaim *metalv1alpha1.ServerClaim) (bool, error) {
+	if owner, ok := serverClaim.Labels[LabelKeyClusterName]; ok {
+		return owner == r.clusterName, nil
+	}
+	if r.bootDiagnostics.ServerClaimSelector == nil {
+		return true, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(r.bootDiagnostics.ServerClaimSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid server claim selector of the boot diagnostics: %w", err)
+	}
+	return selector.Matches(labels.Set(serverClaim.Labels)), nil
+}
+
+// setRegistrationDiagnostic sets the registration diagnostic of the ServerClaim. An empty diagnostic
+// removes it.
+func (r *ServerClaimBindingReconciler) setRegistrationDiagnostic(ctx context.Context, serverClaim *metalv1alpha1.ServerClaim, diagnostic string) error {
+	current, ok := serverClaim.Annotations[AnnotationRegistrationDiagnostic]
+	if (diagnostic == "" && !ok) || (ok && current == diagnostic) {
+		return nil
+	}
+	base := serverClaim.DeepCopy()
+	if diagnostic == "" {
+		delete(serverClaim.Annotations, AnnotationRegistrationDiagnostic)
+	} else {
+		klog.InfoS("Node did not register for ServerClaim", "ServerClaim", client.ObjectKeyFromObject(serverClaim), "Diagnostic", diagnostic)
+		metav1.SetMetaDataAnnotation(&serverClaim.ObjectMeta, AnnotationRegistrationDiagnostic, diagnostic)
+	}
+	if err := r.metalClient.Patch(ctx, serverClaim, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("failed to patch registration diagnostic of ServerClaim %s: %w", client.ObjectKeyFromObject(serverClaim), err)
+	}
+	serverClaimPatchesTotal.WithLabelValues(serverClaimBindingControllerName, "diagnostic").Inc()
+	return nil
+}
+
+// getBootConfiguration returns the ServerBootConfiguration the metal-operator created for the
+// ServerClaim, which shares its name, or nil if there is none.
+func (r *ServerClaimBindingReconciler) getBootConfiguration(ctx context.Context, serverClaim *metalv1alpha1.ServerClaim) (*metalv1alpha1.ServerBootConfiguration, error) {
+	config := &metalv1alpha1.ServerBootConfiguration{}
+	if err := r.metalClient.Get(ctx, client.ObjectKeyFromObject(serverClaim), config); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get ServerBootConfiguration of ServerClaim %s: %w", client.ObjectKeyFromObject(serverClaim), err)
+	}
+	return config, nil
+}
+
+// bootConfigurationCondition reports whether the ServerBootConfiguration is ready.
+func bootConfigurationCondition(serverClaim *metalv1alpha1.ServerClaim, config *metalv1alpha1.ServerBootConfiguration) corev1.NodeCondition {
+	if config == nil {
+		return corev1.NodeCondition{
+			Type:    NodeConditionBootConfiguration,
+			Status:  corev1.ConditionFalse,
+			Reason:  conditionReasonNotFound,
+			Message: fmt.Sprintf("ServerClaim %s has no ServerBootConfiguration", client.ObjectKeyFromObject(serverClaim)),
+		}
+	}
+	state := config.Status.State
+	if state == "" {
+		state = metalv1alpha1.ServerBootConfigurationStatePending
+	}
+	condition := corev1.NodeCondition{
+		Type:    NodeConditionBootConfiguration,
+		Status:  corev1.ConditionFalse,
+		Reason:  string(state),
+		Message: fmt.Sprintf("ServerBootConfiguration %s is %s", config.Name, state),
+	}
+	switch state {
+	case metalv1alpha1.ServerBootConfigurationStateReady:
+		condition.Status = corev1.ConditionTrue
+	case metalv1alpha1.ServerBootConfigurationStateError:
+		condition.Message = fmt.Sprintf("ServerBootConfiguration %s failed: %s", config.Name, bootConfigurationProblems(config))
+	}
+	return condition
+}
+
+// bootConfigurationProblems returns the messages of the conditions of the ServerBootConfiguration that
+// are not true.
+func bootConfigurationProblems(config *metalv1alpha1.ServerBootConfiguration) string {
+	var problems []string
+	for _, condition := range config.Status.Conditions {
+		if condition.Status != metav1.ConditionTrue && condition.Message != "" {
+			problems = append(problems, fmt.Sprintf("%s: %s", condition.Type, condition.Message))
+		}
+	}
+	if len(problems) == 0 {
+		return "no details reported"
+	}
+	return strings.Join(problems, "; ")
+}
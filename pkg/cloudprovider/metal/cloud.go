@@ -8,11 +8,13 @@ import (
 	"fmt"
 	"io"
 
+	infov1alpha1 "github.com/ironcore-dev/cloud-provider-metal/api/v1alpha1"
 	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	cloudprovider "k8s.io/cloud-provider"
 	"k8s.io/klog/v2"
 	capiv1beta1 "sigs.k8s.io/cluster-api/exp/ipam/api/v1beta1"
@@ -26,13 +28,20 @@ const (
 	ProviderName = "metal"
 	// serverClaimMetadataUIDField is the field used to index ServerClaims by their UID
 	serverClaimMetadataUIDField = ".metadata.uid"
+	// serverBMCRefField is the field used to index Servers by the name of their BMC
+	serverBMCRefField = ".spec.bmcRef.name"
 	// LoopbackAddressAnnotation is the annotation used to specify a loopback address for the Machine
 	LoopbackAddressAnnotation = "metal.ironcore.dev/loopback-address"
 )
 
-var metalScheme = runtime.NewScheme()
+var (
+	metalScheme  = runtime.NewScheme()
+	targetScheme = runtime.NewScheme()
+)
 
 func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(targetScheme))
+	utilruntime.Must(infov1alpha1.AddToScheme(targetScheme))
 	utilruntime.Must(metalv1alpha1.AddToScheme(metalScheme))
 	utilruntime.Must(capiv1beta1.AddToScheme(metalScheme))
 
@@ -81,7 +90,9 @@ func (o *cloud) Initialize(clientBuilder cloudprovider.ControllerClientBuilder,
 		klog.ErrorS(err, "Failed to get config", "provider", ProviderName)
 		klog.FlushAndExit(klog.ExitFlushTimeout, 1)
 	}
-	o.targetCluster, err = cluster.New(cfg)
+	o.targetCluster, err = cluster.New(cfg, func(o *cluster.Options) {
+		o.Scheme = targetScheme
+	})
 	if err != nil {
 		klog.ErrorS(err, "Failed to create new cluster", "provider", ProviderName)
 		klog.FlushAndExit(klog.ExitFlushTimeout, 1)
@@ -132,26 +143,110 @@ func (o *cloud) Initialize(clientBuilder cloudprovider.ControllerClientBuilder,
 		klog.ErrorS(err, "Failed to setup ServerClaim informer", "provider", ProviderName)
 		klog.FlushAndExit(klog.ExitFlushTimeout, 1)
 	}
-	serverClaimReconciler := NewServerClaimReconciler(o.targetCluster.GetClient(), o.metalCluster.GetClient(), claimInformer)
-	go func() {
-		if err := serverClaimReconciler.Start(ctx); err != nil {
-			klog.ErrorS(err, "Failed to start ServerClaim reconciler", "provider", ProviderName)
-			klog.FlushAndExit(klog.ExitFlushTimeout, 1)
-		}
-	}()
 	var node corev1.Node
 	nodeInformer, err := o.targetCluster.GetCache().GetInformer(ctx, &node)
 	if err != nil {
 		klog.ErrorS(err, "Failed to setup Node informer", "provider", ProviderName)
 		klog.FlushAndExit(klog.ExitFlushTimeout, 1)
 	}
-	nodeReconciler := NewNodeReconciler(o.targetCluster.GetClient(), o.metalCluster.GetClient(), nodeInformer)
+	var serverMaintenance metalv1alpha1.ServerMaintenance
+	maintenanceInformer, err := o.metalCluster.GetCache().GetInformer(ctx, &serverMaintenance)
+	if err != nil {
+		klog.ErrorS(err, "Failed to setup ServerMaintenance informer", "provider", ProviderName)
+		klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+	}
+	var biosInformers []cache.Informer
+	if o.cloudConfig.BIOSUpdates.Enabled {
+		for _, obj := range []client.Object{&metalv1alpha1.BIOSSettings{}, &metalv1alpha1.BIOSVersion{}} {
+			informer, err := o.metalCluster.GetCache().GetInformer(ctx, obj)
+			if err != nil {
+				klog.ErrorS(err, "Failed to setup BIOS informer", "provider", ProviderName, "type", fmt.Sprintf("%T", obj))
+				klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+			}
+			biosInformers = append(biosInformers, informer)
+		}
+	}
+	var bootConfigurationInformer cache.Informer
+	if o.cloudConfig.BootDiagnostics.Enabled {
+		var serverBootConfiguration metalv1alpha1.ServerBootConfiguration
+		bootConfigurationInformer, err = o.metalCluster.GetCache().GetInformer(ctx, &serverBootConfiguration)
+		if err != nil {
+			klog.ErrorS(err, "Failed to setup ServerBootConfiguration informer", "provider", ProviderName)
+			klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+		}
+	}
+	recorder := o.targetCluster.GetEventRecorder(cloudProviderMetalName)
+	serverClaimReconciler := NewServerClaimReconciler(o.targetCluster.GetClient(), o.targetCluster.GetAPIReader(), o.metalCluster.GetClient(),
+		nodeInformer, claimInformer, recorder, o.cloudConfig)
+	go func() {
+		if err := serverClaimReconciler.Start(ctx); err != nil {
+			klog.ErrorS(err, "Failed to start ServerClaim reconciler", "provider", ProviderName)
+			klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+		}
+	}()
+	nodeReconciler := NewNodeReconciler(o.targetCluster.GetClient(), o.targetCluster.GetAPIReader(), o.metalCluster.GetClient(),
+		nodeInformer, claimInformer, maintenanceInformer, biosInformers, recorder, o.cloudConfig)
 	go func() {
 		if err := nodeReconciler.Start(ctx); err != nil {
 			klog.ErrorS(err, "Failed to start Node reconciler", "provider", ProviderName)
 			klog.FlushAndExit(klog.ExitFlushTimeout, 1)
 		}
 	}()
+	// Servers and BMCs are only watched if a reconciler reports on them.
+	var serverInformer, bmcInformer cache.Informer
+	if o.cloudConfig.ServerHealth.Enabled || o.cloudConfig.ServerInfo.Enabled {
+		if err := o.metalCluster.GetFieldIndexer().IndexField(ctx, &metalv1alpha1.Server{}, serverBMCRefField, func(object client.Object) []string {
+			server := object.(*metalv1alpha1.Server)
+			if server.Spec.BMCRef == nil {
+				return nil
+			}
+			return []string{server.Spec.BMCRef.Name}
+		}); err != nil {
+			klog.ErrorS(err, "Failed to setup field indexer for servers", "provider", ProviderName)
+			klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+		}
+		var server metalv1alpha1.Server
+		serverInformer, err = o.metalCluster.GetCache().GetInformer(ctx, &server)
+		if err != nil {
+			klog.ErrorS(err, "Failed to setup Server informer", "provider", ProviderName)
+			klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+		}
+		var bmc metalv1alpha1.BMC
+		bmcInformer, err = o.metalCluster.GetCache().GetInformer(ctx, &bmc)
+		if err != nil {
+			klog.ErrorS(err, "Failed to setup BMC informer", "provider", ProviderName)
+			klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+		}
+	}
+	if o.cloudConfig.ServerHealth.Enabled {
+		serverHealthReconciler := NewServerHealthReconciler(o.targetCluster.GetClient(), o.metalCluster.GetClient(), nodeInformer, serverInformer, bmcInformer,
+			o.cloudConfig.ServerHealth)
+		go func() {
+			if err := serverHealthReconciler.Start(ctx); err != nil {
+				klog.ErrorS(err, "Failed to start server health reconciler", "provider", ProviderName)
+				klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+			}
+		}()
+	}
+	if o.cloudConfig.ServerInfo.Enabled {
+		serverInfoReconciler := NewMetalServerInfoReconciler(o.targetCluster.GetClient(), o.metalCluster.GetClient(), nodeInformer, claimInformer,
+			serverInformer, bmcInformer, o.cloudConfig.ClusterName)
+		go func() {
+			if err := serverInfoReconciler.Start(ctx); err != nil {
+				klog.ErrorS(err, "Failed to start MetalServerInfo reconciler", "provider", ProviderName)
+				klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+			}
+		}()
+	}
+	bindingReconciler := NewServerClaimBindingReconciler(o.targetCluster.GetClient(), o.metalCluster.GetClient(), nodeInformer, claimInformer,
+		o.metalNamespace, bootConfigurationInformer, recorder, o.cloudConfig.ClusterName, o.cloudConfig.BootDiagnostics,
+		o.cloudConfig.NodeStatusSync)
+	go func() {
+		if err := bindingReconciler.Start(ctx); err != nil {
+			klog.ErrorS(err, "Failed to start ServerClaim binding reconciler", "provider", ProviderName)
+			klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+		}
+	}()
 
 	if !o.metalCluster.GetCache().WaitForCacheSync(ctx) {
 		klog.ErrorS(nil, "Failed to wait for metal cluster cache to sync", "provider", ProviderName)
@@ -7,9 +7,12 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/klog/v2"
@@ -33,9 +36,317 @@ type Networking struct {
 	IPAMKind               *IPAMKind `json:"ipamKind"`
 }
 
+// TopologyLabels names the Server label keys the topology labels are copied from.
+type TopologyLabels struct {
+	Switch string `json:"switch,omitempty"`
+	Rack   string `json:"rack,omitempty"`
+	Pod    string `json:"pod,omitempty"`
+}
+
+// Topology configures how the switch, rack and pod topology labels of a Node are derived.
+type Topology struct {
+	// SwitchFromNeighbors derives the switch label from the LLDP neighbours reported in the Server status.
+	SwitchFromNeighbors bool `json:"switchFromNeighbors"`
+	// NeighborInterface restricts the neighbour lookup to the network interface with the given name.
+	NeighborInterface string `json:"neighborInterface,omitempty"`
+	// ServerLabels takes precedence over the neighbour information if the Server carries the label.
+	ServerLabels TopologyLabels `json:"serverLabels"`
+}
+
+// NodeDeletion configures how the ServerClaim of a deleted Node is released.
+type NodeDeletion struct {
+	// RemoveClusterLabel removes the cluster name label from the ServerClaim.
+	RemoveClusterLabel bool `json:"removeClusterLabel"`
+	// PowerOff powers off the ServerClaim.
+	PowerOff bool `json:"powerOff"`
+	// DeleteServerClaim deletes the ServerClaim so that the server returns to the pool.
+	DeleteServerClaim bool `json:"deleteServerClaim"`
+}
+
+// Enabled reports whether anything has to be done for the ServerClaim of a deleted Node.
+func (n NodeDeletion) Enabled() bool {
+	return n.RemoveClusterLabel || n.PowerOff || n.DeleteServerClaim
+}
+
+// ServerClaimProtection configures the protection of ServerClaims that back a registered Node.
+type ServerClaimProtection struct {
+	// Enabled adds a finalizer to the ServerClaim of a registered Node, so that the Node is drained
+	// before the ServerClaim is released.
+	Enabled bool `json:"enabled"`
+	// DrainTimeout is the time after which the finalizer is removed even if the Node is not drained yet.
+	// Defaults to 10 minutes.
+	DrainTimeout metav1.Duration `json:"drainTimeout,omitempty"`
+}
+
+// GetDrainTimeout returns the configured drain timeout or the default if none is set.
+func (p ServerClaimProtection) GetDrainTimeout() time.Duration {
+	if p.DrainTimeout.Duration <= 0 {
+		return DefaultDrainTimeout
+	}
+	return p.DrainTimeout.Duration
+}
+
+// Shutdown configures when the server of a Node counts as shut down.
+type Shutdown struct {
+	// MaintenanceIsShutdown treats a server that is held in maintenance as shut down, regardless of its power state.
+	MaintenanceIsShutdown bool `json:"maintenanceIsShutdown"`
+}
+
+// PowerOff configures how the power-off annotation of a Node is applied to its ServerClaim.
+type PowerOff struct {
+	// Drain cordons the Node and evicts its pods before the ServerClaim is powered off.
+	Drain bool `json:"drain"`
+	// GracePeriod is the time the ServerClaim stays powered on after the Node was drained, e.g. to let
+	// volumes detach. Defaults to 30 seconds.
+	GracePeriod metav1.Duration `json:"gracePeriod,omitempty"`
+	// DrainTimeout is the time after which the ServerClaim is powered off even if the Node is not drained yet.
+	// Defaults to 10 minutes.
+	DrainTimeout metav1.Duration `json:"drainTimeout,omitempty"`
+}
+
+// GetGracePeriod returns the configured grace period after the drain or the default if none is set.
+func (p PowerOff) GetGracePeriod() time.Duration {
+	if p.GracePeriod.Duration <= 0 {
+		return DefaultPowerOffGracePeriod
+	}
+	return p.GracePeriod.Duration
+}
+
+// GetDrainTimeout returns the configured drain timeout or the default if none is set.
+func (p PowerOff) GetDrainTimeout() time.Duration {
+	if p.DrainTimeout.Duration <= 0 {
+		return DefaultDrainTimeout
+	}
+	return p.DrainTimeout.Duration
+}
+
+// MaintenanceAutomation configures the automatic approval of server maintenance.
+type MaintenanceAutomation struct {
+	// Enabled cordons and drains a Node whose server needs maintenance and approves the maintenance
+	// once the Node is drained. The Node is uncordoned after the maintenance.
+	Enabled bool `json:"enabled"`
+	// DrainTimeout is the time after which the maintenance is approved even if the Node is not drained yet.
+	// Defaults to 10 minutes.
+	DrainTimeout metav1.Duration `json:"drainTimeout,omitempty"`
+	// Budget limits the number of Nodes that are in maintenance at the same time.
+	Budget MaintenanceBudget `json:"budget"`
+}
+
+// GetDrainTimeout returns the configured drain timeout or the default if none is set.
+func (m MaintenanceAutomation) GetDrainTimeout() time.Duration {
+	if m.DrainTimeout.Duration <= 0 {
+		return DefaultDrainTimeout
+	}
+	return m.DrainTimeout.Duration
+}
+
+// MaintenanceBudget limits the number of Nodes that are drained for or held in maintenance at the same
+// time. A Node takes part in the budget from the start of its drain until it is Ready again after the
+// maintenance. Nodes that are reprovisioned take part in the budget as well. Zero disables a limit.
+type MaintenanceBudget struct {
+	// MaxUnavailable is the number of Nodes of the cluster that may be in maintenance at the same time.
+	MaxUnavailable int `json:"maxUnavailable,omitempty"`
+	// MaxUnavailablePerZone is the number of Nodes of a zone that may be in maintenance at the same time.
+	MaxUnavailablePerZone int `json:"maxUnavailablePerZone,omitempty"`
+}
+
+// Limited reports whether the budget limits the number of Nodes in maintenance.
+func (b MaintenanceBudget) Limited() bool {
+	return b.MaxUnavailable > 0 || b.MaxUnavailablePerZone > 0
+}
+
+// MaintenanceWindow is a recurring time window in which server maintenance may be approved.
+type MaintenanceWindow struct {
+	// Schedule is a cron expression for the start of the window, e.g. "0 2 * * 6".
+	Schedule string `json:"schedule"`
+	// Duration is the time the window stays open after its start.
+	Duration metav1.Duration `json:"duration"`
+	// TimeZone is the IANA time zone the schedule is evaluated in. Defaults to UTC.
+	TimeZone string `json:"timeZone,omitempty"`
+	// NodeSelector restricts the window to the matching Nodes. By default it applies to all Nodes.
+	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+}
+
+// ServerMaintenanceDefaults configures the defaults of the ServerMaintenance created for a Node that requests
+// maintenance. Nodes can override them with annotations.
+type ServerMaintenanceDefaults struct {
+	// Policy is OwnerApproval or Enforced. Defaults to OwnerApproval.
+	Policy metalv1alpha1.ServerMaintenancePolicy `json:"policy,omitempty"`
+	// Priority orders the maintenances of a server, higher values are processed first. Defaults to 100.
+	Priority *int32 `json:"priority,omitempty"`
+	// Reason describes why the maintenance is requested.
+	Reason string `json:"reason,omitempty"`
+	// BootImage is booted on the server during the maintenance, e.g. a diagnostic image.
+	BootImage string `json:"bootImage,omitempty"`
+	// IgnitionSecretName names the Secret in the metal namespace with the ignition of the BootImage.
+	IgnitionSecretName string `json:"ignitionSecretName,omitempty"`
+}
+
+// MaintenanceHistory configures the history of server maintenances that is kept in a ConfigMap of the
+// target cluster.
+type MaintenanceHistory struct {
+	// Enabled records the request, approval and completion of the server maintenances of Nodes.
+	Enabled bool `json:"enabled"`
+	// Namespace is the namespace of the ConfigMap. Defaults to kube-system. The RBAC of the
+	// cloud-controller-manager has to grant access to ConfigMaps in it.
+	Namespace string `json:"namespace,omitempty"`
+	// MaxRecordsPerNode is the number of maintenances kept per Node, older ones are dropped. Defaults to 10.
+	MaxRecordsPerNode int `json:"maxRecordsPerNode,omitempty"`
+}
+
+// GetNamespace returns the configured namespace of the ConfigMap or the default if none is set.
+func (h MaintenanceHistory) GetNamespace() string {
+	if h.Namespace == "" {
+		return DefaultMaintenanceHistoryNamespace
+	}
+	return h.Namespace
+}
+
+// GetMaxRecordsPerNode returns the configured number of maintenances kept per Node or the default if none is set.
+func (h MaintenanceHistory) GetMaxRecordsPerNode() int {
+	if h.MaxRecordsPerNode <= 0 {
+		return DefaultMaintenanceHistoryMaxRecordsPerNode
+	}
+	return h.MaxRecordsPerNode
+}
+
+// BIOSUpdates configures the BIOS updates that are requested with annotations on Nodes.
+type BIOSUpdates struct {
+	// Enabled turns the BIOS annotations of Nodes into BIOSSettings and BIOSVersion objects for their servers.
+	// These are applied by the metal-operator once the maintenance of the server is approved.
+	Enabled bool `json:"enabled"`
+}
+
+// Reimage configures the reprovisioning of servers that is requested with annotations on Nodes.
+type Reimage struct {
+	// Enabled allows reprovisioning the server of a Node with the image requested in its annotations.
+	Enabled bool `json:"enabled"`
+	// DrainGracePeriod is the time after which the server is reprovisioned even if the Node is not drained yet.
+	// Defaults to 5 minutes.
+	DrainGracePeriod metav1.Duration `json:"drainGracePeriod,omitempty"`
+	// RegistrationTimeout is the time after which the reimage of a Node that did not register again after the
+	// power cycle fails and the Node is uncordoned. Defaults to 30 minutes.
+	RegistrationTimeout metav1.Duration `json:"registrationTimeout,omitempty"`
+}
+
+// GetDrainGracePeriod returns the configured drain grace period or the default if none is set.
+func (r Reimage) GetDrainGracePeriod() time.Duration {
+	if r.DrainGracePeriod.Duration <= 0 {
+		return DefaultReimageDrainGracePeriod
+	}
+	return r.DrainGracePeriod.Duration
+}
+
+// GetRegistrationTimeout returns the configured registration timeout or the default if none is set.
+func (r Reimage) GetRegistrationTimeout() time.Duration {
+	if r.RegistrationTimeout.Duration <= 0 {
+		return DefaultReimageRegistrationTimeout
+	}
+	return r.RegistrationTimeout.Duration
+}
+
+// BootDiagnostics configures how the boot configuration of the servers of Nodes is reported.
+type BootDiagnostics struct {
+	// Enabled reports the state of the ServerBootConfiguration of a ServerClaim in the BootConfiguration
+	// condition of its Node and diagnoses ServerClaims whose Node does not register.
+	Enabled bool `json:"enabled"`
+	// RegistrationTimeout is the time after the ServerBootConfiguration was created after which a ServerClaim
+	// without Node gets a diagnostic annotation. Defaults to 30 minutes.
+	RegistrationTimeout metav1.Duration `json:"registrationTimeout,omitempty"`
+	// ServerClaimSelector selects the ServerClaims of this cluster that are diagnosed before their Node
+	// registered. ServerClaims labelled with the name of this cluster are always diagnosed and those
+	// labelled with the name of another cluster never. If no selector is set, all other ServerClaims of
+	// the metal namespace are diagnosed, so it has to be set if clusters share the metal namespace.
+	ServerClaimSelector *metav1.LabelSelector `json:"serverClaimSelector,omitempty"`
+}
+
+// GetRegistrationTimeout returns the configured registration timeout or the default if none is set.
+func (b BootDiagnostics) GetRegistrationTimeout() time.Duration {
+	if b.RegistrationTimeout.Duration <= 0 {
+		return DefaultBootDiagnosticsRegistrationTimeout
+	}
+	return b.RegistrationTimeout.Duration
+}
+
+// NodeStatusSync configures the annotations that report the status of a Node on its ServerClaim, so that
+// it can be seen from the metal cluster.
+type NodeStatusSync struct {
+	// Enabled annotates the ServerClaim of a Node with its name, readiness, kubelet version, whether it is
+	// cordoned and the last heartbeat. The annotations are removed once the Node is gone.
+	Enabled bool `json:"enabled"`
+	// HeartbeatInterval is the minimum time between updates of the ServerClaim that only advance the
+	// heartbeat. Other changes are written right away. Defaults to 5 minutes.
+	HeartbeatInterval metav1.Duration `json:"heartbeatInterval,omitempty"`
+}
+
+// GetHeartbeatInterval returns the configured heartbeat interval or the default if none is set.
+func (s NodeStatusSync) GetHeartbeatInterval() time.Duration {
+	if s.HeartbeatInterval.Duration <= 0 {
+		return DefaultNodeStatusHeartbeatInterval
+	}
+	return s.HeartbeatInterval.Duration
+}
+
+// ServerInfo configures the MetalServerInfo objects that describe the servers of Nodes in the target cluster.
+type ServerInfo struct {
+	// Enabled maintains a MetalServerInfo named after each Node with the inventory of its server and BMC.
+	// The MetalServerInfo CRD has to be installed in the target cluster.
+	Enabled bool `json:"enabled"`
+}
+
+// Propagation selects the labels and annotations of a ServerClaim that are copied to its Node. An entry
+// is either a key or a prefix ending in "*", e.g. "example.com/*". Keys removed from the ServerClaim or
+// from the configuration are removed from the Node as well. The keys the provider manages on Nodes and
+// the maintenance and power keys are never copied, and nothing is copied from a ServerClaim owned by
+// another cluster.
+type Propagation struct {
+	// Labels are the label keys and prefixes of the ServerClaim that are copied to the Node.
+	Labels []string `json:"labels,omitempty"`
+	// Annotations are the annotation keys and prefixes of the ServerClaim that are copied to the Node.
+	Annotations []string `json:"annotations,omitempty"`
+}
+
+// ServerHealthTaints selects the server health conditions that taint a Node with NoSchedule.
+type ServerHealthTaints struct {
+	// Unhealthy taints the Node if its server is not healthy.
+	Unhealthy bool `json:"unhealthy"`
+	// BMCUnreachable taints the Node if the BMC of its server is not reachable.
+	BMCUnreachable bool `json:"bmcUnreachable"`
+	// InMaintenance taints the Node if its server is in maintenance.
+	InMaintenance bool `json:"inMaintenance"`
+}
+
+// ServerHealth configures how the health of a server is reflected on its Node.
+type ServerHealth struct {
+	// Enabled reports the health of the server of each Node in Node conditions.
+	Enabled bool               `json:"enabled"`
+	Taints  ServerHealthTaints `json:"taints"`
+}
+
 type CloudConfig struct {
-	ClusterName string     `json:"clusterName"`
-	Networking  Networking `json:"networking"`
+	ClusterName           string                `json:"clusterName"`
+	Networking            Networking            `json:"networking"`
+	Topology              Topology              `json:"topology"`
+	NodeDeletion          NodeDeletion          `json:"nodeDeletion"`
+	ServerClaimProtection ServerClaimProtection `json:"serverClaimProtection"`
+	Shutdown              Shutdown              `json:"shutdown"`
+	ServerHealth          ServerHealth          `json:"serverHealth"`
+	PowerOff              PowerOff              `json:"powerOff"`
+	MaintenanceAutomation MaintenanceAutomation `json:"maintenanceAutomation"`
+	// MaintenanceWindows restrict the approval of server maintenance. Nodes that no window applies
+	// to are not restricted.
+	MaintenanceWindows []MaintenanceWindow       `json:"maintenanceWindows,omitempty"`
+	ServerMaintenance  ServerMaintenanceDefaults `json:"serverMaintenance"`
+	MaintenanceHistory MaintenanceHistory        `json:"maintenanceHistory"`
+	BIOSUpdates        BIOSUpdates               `json:"biosUpdates"`
+	Reimage            Reimage                   `json:"reimage"`
+	BootDiagnostics    BootDiagnostics           `json:"bootDiagnostics"`
+	ServerInfo         ServerInfo                `json:"serverInfo"`
+	NodeStatusSync     NodeStatusSync            `json:"nodeStatusSync"`
+	Propagation        Propagation               `json:"propagation"`
+
+	// maintenanceWindowSchedules are the MaintenanceWindows parsed when the config is loaded.
+	maintenanceWindowSchedules []maintenanceWindowSchedule
 }
 
 var (
@@ -48,6 +359,11 @@ func AddExtraFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&MetalKubeconfigPath, "metal-kubeconfig", "", "Path to the metal cluster kubeconfig.")
 	fs.StringVar(&MetalNamespace, "metal-namespace", "", "Override metal cluster namespace.")
 	fs.IntVar(&PodPrefixSize, "pod-prefix-size", 0, "Prefix size for the pod prefix, zero or less disables pod prefix assignment.")
+	fs.StringSliceVar(&NodeAdmissionAllowedGroups, "node-admission-allowed-groups", []string{"system:masters"},
+		"Groups that may change the maintenance and power labels and annotations of Nodes if the "+NodeAdmissionWebhookName+" webhook is enabled.")
+	fs.StringSliceVar(&NodeAdmissionAllowedServiceAccounts, "node-admission-allowed-service-accounts", []string{"kube-system/cloud-controller-manager"},
+		"Service accounts as namespace/name that may change the maintenance and power labels and annotations of Nodes if the "+
+			NodeAdmissionWebhookName+" webhook is enabled. Must include the service account of the cloud controller manager.")
 }
 
 func LoadCloudProviderConfig(f io.Reader) (*CloudProviderConfig, error) {
@@ -66,6 +382,21 @@ func LoadCloudProviderConfig(f io.Reader) (*CloudProviderConfig, error) {
 		return nil, fmt.Errorf("clusterName missing in cloud config")
 	}
 
+	if cloudConfig.maintenanceWindowSchedules, err = parseMaintenanceWindows(cloudConfig.MaintenanceWindows); err != nil {
+		return nil, fmt.Errorf("invalid cloud config: %w", err)
+	}
+	if err := validateServerMaintenancePolicy(cloudConfig.ServerMaintenance.Policy); err != nil {
+		return nil, fmt.Errorf("invalid cloud config: serverMaintenance: %w", err)
+	}
+	if selector := cloudConfig.BootDiagnostics.ServerClaimSelector; selector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(selector); err != nil {
+			return nil, fmt.Errorf("invalid cloud config: bootDiagnostics: serverClaimSelector: %w", err)
+		}
+	}
+	if err := validatePropagation(cloudConfig.Propagation); err != nil {
+		return nil, fmt.Errorf("invalid cloud config: propagation: %w", err)
+	}
+
 	cloudProviderConfig := &CloudProviderConfig{cloudConfig: *cloudConfig}
 
 	if MetalKubeconfigPath == "" {
@@ -6,9 +6,11 @@ package metal
 import (
 	"os"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/clientcmd/api"
 	"sigs.k8s.io/yaml"
@@ -119,4 +121,18 @@ var _ = Describe("Config", func() {
 		Expect(err.Error()).To(Equal("clusterName missing in cloud config"))
 		Expect(config).To(BeNil())
 	})
+
+	It("should fail on an invalid maintenance window in cloud provider config", func() {
+		invalidConfig := CloudConfig{
+			ClusterName:        "my-cluster",
+			MaintenanceWindows: []MaintenanceWindow{{Schedule: "not a schedule", Duration: metav1.Duration{Duration: time.Hour}}},
+		}
+		configData, err := yaml.Marshal(invalidConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		configReader := strings.NewReader(string(configData))
+		config, err := LoadCloudProviderConfig(configReader)
+		Expect(err).To(MatchError(ContainSubstring("maintenanceWindows[0]")))
+		Expect(config).To(BeNil())
+	})
 })
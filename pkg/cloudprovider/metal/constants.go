@@ -16,12 +16,95 @@ const (
 	AnnotationKeyServiceUID = "service-uid"
 	// AnnotationPowerOff can be set to any value to power off a server
 	AnnotationPowerOff = "metal.ironcore.dev/power-off"
+	// AnnotationPowerAction can be set to reboot, power-cycle or soft-off to run a power action once per request ID
+	AnnotationPowerAction = "metal.ironcore.dev/power-action"
+	// AnnotationPowerActionID identifies a power action request, a new ID runs the power action again
+	AnnotationPowerActionID = "metal.ironcore.dev/power-action-id"
+	// AnnotationPowerActionHandledID is set to the ID of the last power action request that was handled
+	AnnotationPowerActionHandledID = "metal.ironcore.dev/power-action-handled-id"
+	// AnnotationPowerActionResult reports the result of the last power action request that was handled
+	AnnotationPowerActionResult = "metal.ironcore.dev/power-action-result"
+	// AnnotationDrainCordoned is set on a node that was cordoned by a drain, only such a node is uncordoned once the drain is released
+	AnnotationDrainCordoned = "metal.ironcore.dev/drain-cordoned"
+	// AnnotationMaintenanceApprovalPriority orders the Nodes waiting for a maintenance approval, higher values are approved first
+	AnnotationMaintenanceApprovalPriority = "metal.ironcore.dev/maintenance-approval-priority"
+	// AnnotationMaintenanceRequestedAt is set to the time at which a Node was first seen needing maintenance
+	AnnotationMaintenanceRequestedAt = "metal.ironcore.dev/maintenance-requested-at"
+	// AnnotationMaintenanceApprovedAt is set to the time at which the maintenance approval of a Node was first seen
+	AnnotationMaintenanceApprovedAt = "metal.ironcore.dev/maintenance-approved-at"
+	// AnnotationNextMaintenanceWindow is set to the start and end of the maintenance window of a node that is open or opens next
+	AnnotationNextMaintenanceWindow = "metal.ironcore.dev/next-maintenance-window"
+	// AnnotationMaintenancePolicy overrides the policy of the ServerMaintenance requested by a node
+	AnnotationMaintenancePolicy = "metal.ironcore.dev/maintenance-policy"
+	// AnnotationMaintenancePriority overrides the priority of the ServerMaintenance requested by a node
+	AnnotationMaintenancePriority = "metal.ironcore.dev/maintenance-priority"
+	// AnnotationMaintenanceReason overrides the reason of the ServerMaintenance requested by a node
+	AnnotationMaintenanceReason = "metal.ironcore.dev/maintenance-reason"
+	// AnnotationMaintenanceBootImage overrides the image booted during the ServerMaintenance requested by a node
+	AnnotationMaintenanceBootImage = "metal.ironcore.dev/maintenance-boot-image"
+	// AnnotationServerMaintenanceState is set to the state of the ServerMaintenance of a node
+	AnnotationServerMaintenanceState = "metal.ironcore.dev/server-maintenance-state"
+	// AnnotationServerMaintenanceStartTime is set to the time at which the ServerMaintenance of a node went into maintenance
+	AnnotationServerMaintenanceStartTime = "metal.ironcore.dev/server-maintenance-start-time"
+	// AnnotationServerMaintenanceReason is set to the reason of the ServerMaintenance of a node
+	AnnotationServerMaintenanceReason = "metal.ironcore.dev/server-maintenance-reason"
+	// AnnotationBIOSSettings can be set to a JSON object of BIOS settings to apply to the server of a node once its maintenance is approved
+	AnnotationBIOSSettings = "metal.ironcore.dev/bios-settings"
+	// AnnotationBIOSSettingsVersion is the BIOS version the settings of a node apply to, it defaults to the current BIOS version of the server
+	AnnotationBIOSSettingsVersion = "metal.ironcore.dev/bios-settings-version"
+	// AnnotationBIOSVersion can be set to the BIOS version to upgrade the server of a node to once its maintenance is approved
+	AnnotationBIOSVersion = "metal.ironcore.dev/bios-version"
+	// AnnotationBIOSVersionImage is the URI of the image the BIOS of a node is upgraded with
+	AnnotationBIOSVersionImage = "metal.ironcore.dev/bios-version-image"
+	// AnnotationReimageImage can be set to the image to reprovision the server of a node with. The node is
+	// drained, the image of its ServerClaim is updated and the server is power-cycled.
+	AnnotationReimageImage = "metal.ironcore.dev/reimage-image"
+	// AnnotationReimageIgnitionSecret optionally names the ignition secret of the ServerClaim to reprovision a node with
+	AnnotationReimageIgnitionSecret = "metal.ironcore.dev/reimage-ignition-secret"
+	// AnnotationReimageBootID is set on a ServerClaim that is reprovisioned to the boot ID of its node before the power cycle
+	AnnotationReimageBootID = "metal.ironcore.dev/reimage-boot-id"
+	// AnnotationReimagePowerCycleTime is set on a ServerClaim that is reprovisioned to the time its server was power-cycled
+	AnnotationReimagePowerCycleTime = "metal.ironcore.dev/reimage-power-cycle-time"
+	// AnnotationRegistrationDiagnostic is set on a ServerClaim whose node did not register in time to what
+	// is known about the boot of its server
+	AnnotationRegistrationDiagnostic = "metal.ironcore.dev/registration-diagnostic"
+	// AnnotationNodeName is set on a ServerClaim to the name of its node if the node status sync is enabled
+	AnnotationNodeName = "metal.ironcore.dev/node-name"
+	// AnnotationNodeReady is set on a ServerClaim to the status of the Ready condition of its node
+	AnnotationNodeReady = "metal.ironcore.dev/node-ready"
+	// AnnotationNodeKubeletVersion is set on a ServerClaim to the kubelet version of its node
+	AnnotationNodeKubeletVersion = "metal.ironcore.dev/node-kubelet-version"
+	// AnnotationNodeUnschedulable is set on a ServerClaim to whether its node is cordoned
+	AnnotationNodeUnschedulable = "metal.ironcore.dev/node-unschedulable"
+	// AnnotationNodeLastHeartbeat is set on a ServerClaim to the last heartbeat of the Ready condition of its node
+	AnnotationNodeLastHeartbeat = "metal.ironcore.dev/node-last-heartbeat"
+	// AnnotationPropagatedLabels is set on a node to the comma-separated keys of the labels copied from its ServerClaim
+	AnnotationPropagatedLabels = "metal.ironcore.dev/propagated-labels"
+	// AnnotationPropagatedAnnotations is set on a node to the comma-separated keys of the annotations copied from its ServerClaim
+	AnnotationPropagatedAnnotations = "metal.ironcore.dev/propagated-annotations"
+	// AnnotationMigrateToCluster can be set on a ServerClaim to the name of the cluster that may take it over
+	// from the cluster it is currently labelled for
+	AnnotationMigrateToCluster = "metal.ironcore.dev/migrate-to-cluster"
 	// LabelKeyClusterName is the label key name used to identify the cluster name in Kubernetes labels
 	LabelKeyClusterName = "kubernetes.io/cluster"
 	// LabelKeyServerClaimName is the label key name used to identify the server claim's name in Kubernetes labels
 	LabelKeyServerClaimName = "metal.ironcore.dev/server-claim-name"
 	// LabelKeyServerClaimNamespace is the label key name used to identify the server claim's namespace in Kubernetes labels
 	LabelKeyServerClaimNamespace = "metal.ironcore.dev/server-claim-namespace"
+	// LabelKeyTopologySwitch is the label key name used to identify the top-of-rack switch of a node
+	LabelKeyTopologySwitch = "topology.metal.ironcore.dev/switch"
+	// LabelKeyTopologyRack is the label key name used to identify the rack of a node
+	LabelKeyTopologyRack = "topology.metal.ironcore.dev/rack"
+	// LabelKeyTopologyPod is the label key name used to identify the pod of a node
+	LabelKeyTopologyPod = "topology.metal.ironcore.dev/pod"
+	// TaintKeyServerMismatch is the taint key used to mark a node whose server does not match its ServerClaim
+	TaintKeyServerMismatch = "metal.ironcore.dev/server-mismatch"
+	// TaintKeyServerUnhealthy is the taint key used to mark a node whose server is not healthy
+	TaintKeyServerUnhealthy = "metal.ironcore.dev/server-unhealthy"
+	// TaintKeyBMCUnreachable is the taint key used to mark a node whose BMC is not reachable
+	TaintKeyBMCUnreachable = "metal.ironcore.dev/bmc-unreachable"
+	// TaintKeyServerInMaintenance is the taint key used to mark a node whose server is in maintenance
+	TaintKeyServerInMaintenance = "metal.ironcore.dev/server-in-maintenance"
 	// TrueStr contains string value of "true"
 	TrueStr string = "true"
 	// NodeProviderIDField is the field path to the providerID on a node object
@@ -30,4 +113,26 @@ const (
 	BaseReconcilerDelay time.Duration = 5 * time.Second
 	// MaxReconcilerDelay is the max delay of a reconciler with exponential backoff
 	MaxReconcilerDelay time.Duration = 5 * time.Minute
+	// DefaultDrainTimeout is the time after which a Node drain is given up if none is configured
+	DefaultDrainTimeout time.Duration = 10 * time.Minute
+	// DefaultPowerOffGracePeriod is the time the server of a drained Node keeps running before it is powered off if none is configured
+	DefaultPowerOffGracePeriod time.Duration = 30 * time.Second
+	// DefaultReimageDrainGracePeriod is the time a Node is drained before its server is reprovisioned if none is configured
+	DefaultReimageDrainGracePeriod time.Duration = 5 * time.Minute
+	// DefaultReimageRegistrationTimeout is the time a reprovisioned Node has to register again if none is configured
+	DefaultReimageRegistrationTimeout time.Duration = 30 * time.Minute
+	// DefaultBootDiagnosticsRegistrationTimeout is the time a bound ServerClaim has to get a Node before it is diagnosed if none is configured
+	DefaultBootDiagnosticsRegistrationTimeout time.Duration = 30 * time.Minute
+	// DefaultNodeStatusHeartbeatInterval is the minimum time between heartbeat updates of a ServerClaim if none is configured
+	DefaultNodeStatusHeartbeatInterval time.Duration = 5 * time.Minute
+	// MaintenanceBudgetRequeueDelay is the delay after which a Node waiting for the maintenance budget is checked again
+	MaintenanceBudgetRequeueDelay time.Duration = 30 * time.Second
+	// DrainRequeueDelay is the delay after which the progress of a Node drain is checked again
+	DrainRequeueDelay time.Duration = 5 * time.Second
+	// MaintenanceHistoryConfigMapName is the name of the ConfigMap the maintenance history is kept in
+	MaintenanceHistoryConfigMapName = "metal-maintenance-history"
+	// DefaultMaintenanceHistoryNamespace is the namespace of the maintenance history ConfigMap if none is configured
+	DefaultMaintenanceHistoryNamespace = "kube-system"
+	// DefaultMaintenanceHistoryMaxRecordsPerNode is the number of maintenances kept per node if none is configured
+	DefaultMaintenanceHistoryMaxRecordsPerNode = 10
 )
@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// podNodeNameField is the field selector used to list the pods scheduled on a node
+	podNodeNameField = "spec.nodeName"
+	// mirrorPodAnnotation marks static pods mirrored into the API server by the kubelet
+	mirrorPodAnnotation = "kubernetes.io/config.mirror"
+)
+
+// drainConditionTypes are the Node conditions that track why a Node was drained. A Node is only
+// uncordoned once none of them is left.
+var drainConditionTypes = []corev1.NodeConditionType{
+	NodeConditionPowerOffDrained,
+	NodeConditionMaintenanceDrained,
+}
+
+// nodeDrainer cordons Nodes and evicts their pods through the eviction API, so that
+// PodDisruptionBudgets are honoured.
+type nodeDrainer struct {
+	targetClient client.Client
+	// targetReader lists pods directly from the API server to not cache all pods of the cluster.
+	targetReader client.Reader
+}
+
+func newNodeDrainer(targetClient client.Client, targetReader client.Reader) nodeDrainer {
+	return nodeDrainer{
+		targetClient: targetClient,
+		targetReader: targetReader,
+	}
+}
+
+// cordon marks the Node as unschedulable. The Node is annotated as cordoned by the drain, so that
+// a Node an administrator cordoned stays cordoned once the drain is released.
+func (d *nodeDrainer) cordon(ctx context.Context, node *corev1.Node) error {
+	if node.Spec.Unschedulable {
+		return nil
+	}
+	base := node.DeepCopy()
+	node.Spec.Unschedulable = true
+	metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationDrainCordoned, TrueStr)
+	if err := d.targetClient.Patch(ctx, node, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("failed to cordon Node %s: %w", node.Name, err)
+	}
+	return nil
+}
+
+// uncordon marks the Node as schedulable again if it was cordoned by a drain.
+func (d *nodeDrainer) uncordon(ctx context.Context, node *corev1.Node) error {
+	if _, ok := node.Annotations[AnnotationDrainCordoned]; !ok {
+		return nil
+	}
+	base := node.DeepCopy()
+	node.Spec.Unschedulable = false
+	delete(node.Annotations, AnnotationDrainCordoned)
+	if err := d.targetClient.Patch(ctx, node, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("failed to uncordon Node %s: %w", node.Name, err)
+	}
+	return nil
+}
+
+// release removes the condition that tracks a drain of the Node and uncordons it if a drain cordoned
+// it, unless another drain still holds it cordoned.
+func (d *nodeDrainer) release(ctx context.Context, node *corev1.Node, conditionType corev1.NodeConditionType) error {
+	if getNodeCondition(node, conditionType) == nil {
+		return nil
+	}
+	if !drainHeld(node, conditionType) {
+		klog.InfoS("Uncordoning drained Node", "Node", node.Name, "Condition", conditionType)
+		if err := d.uncordon(ctx, node); err != nil {
+			return err
+		}
+	}
+	return removeNodeCondition(ctx, d.targetClient, node, conditionType)
+}
+
+// drainHeld reports whether a drain other than the given one still holds the Node cordoned. A Node
+// that is reprovisioned stays cordoned until it registered again.
+func drainHeld(node *corev1.Node, conditionType corev1.NodeConditionType) bool {
+	if conditionType != NodeConditionReimage && reimageInProgress(node) {
+		return true
+	}
+	for _, other := range drainConditionTypes {
+		if other != conditionType && getNodeCondition(node, other) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// drain cordons the Node and requests the eviction of all pods that have to leave it.
+// It reports whether the Node is drained, i.e. no such pod is left. Evictions that are
+// refused because of a PodDisruptionBudget are retried on the next call.
+func (d *nodeDrainer) drain(ctx context.Context, node *corev1.Node) (bool, error) {
+	if err := d.cordon(ctx, node); err != nil {
+		return false, err
+	}
+
+	pods := &corev1.PodList{}
+	if err := d.targetReader.List(ctx, pods, client.MatchingFields{podNodeNameField: node.Name}); err != nil {
+		return false, fmt.Errorf("failed to list pods of Node %s: %w", node.Name, err)
+	}
+
+	remaining := 0
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if !podNeedsEviction(pod) {
+			continue
+		}
+		remaining++
+		if !pod.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		eviction := &policyv1.Eviction{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      pod.Name,
+				Namespace: pod.Namespace,
+			},
+		}
+		if err := d.targetClient.SubResource("eviction").Create(ctx, pod, eviction); err != nil {
+			switch {
+			case apierrors.IsNotFound(err):
+				remaining--
+			case apierrors.IsTooManyRequests(err):
+				klog.V(2).InfoS("Eviction of pod blocked by disruption budget", "Node", node.Name, "Pod", client.ObjectKeyFromObject(pod))
+			default:
+				return false, fmt.Errorf("failed to evict pod %s from Node %s: %w", client.ObjectKeyFromObject(pod), node.Name, err)
+			}
+		}
+	}
+
+	klog.V(2).InfoS("Draining Node", "Node", node.Name, "RemainingPods", remaining)
+	return remaining == 0, nil
+}
+
+// podNeedsEviction reports whether a pod has to be evicted to drain its Node. Finished pods,
+// mirror pods and pods managed by a DaemonSet stay on the Node.
+func podNeedsEviction(pod *corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+	if _, ok := pod.Annotations[mirrorPodAnnotation]; ok {
+		return false
+	}
+	if owner := metav1.GetControllerOf(pod); owner != nil && owner.Kind == "DaemonSet" {
+		return false
+	}
+	return true
+}
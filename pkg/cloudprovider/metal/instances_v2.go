@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"net/url"
 	"strings"
 
@@ -67,6 +68,9 @@ func (o *metalInstancesV2) InstanceShutdown(ctx context.Context, node *corev1.No
 	if serverClaim == nil {
 		return false, cloudprovider.InstanceNotFound
 	}
+	if serverClaim.Spec.ServerRef == nil {
+		return false, serverClaimNotBoundError(serverClaim)
+	}
 
 	server := &metalv1alpha1.Server{}
 	if err := o.metalClient.Get(ctx, client.ObjectKey{Name: serverClaim.Spec.ServerRef.Name}, server); err != nil {
@@ -75,9 +79,12 @@ func (o *metalInstancesV2) InstanceShutdown(ctx context.Context, node *corev1.No
 		}
 		return false, fmt.Errorf("failed to get server object for node %s: %w", node.Name, err)
 	}
+	if err := verifyServerForNode(server, node); err != nil {
+		return false, err
+	}
 
-	nodeShutDownStatus := server.Status.PowerState == metalv1alpha1.ServerOffPowerState
-	klog.V(2).InfoS("Instance shut down status", "NodeShutdown", nodeShutDownStatus)
+	nodeShutDownStatus, reason := isServerShutDown(serverClaim, server, o.cloudConfig.Shutdown)
+	klog.V(2).InfoS("Instance shut down status", "NodeShutdown", nodeShutDownStatus, "Reason", reason)
 	return nodeShutDownStatus, nil
 }
 
@@ -99,26 +106,18 @@ func (o *metalInstancesV2) InstanceMetadata(ctx context.Context, node *corev1.No
 		return nil, cloudprovider.InstanceNotFound
 	}
 	klog.V(2).InfoS("Found server claim for node", "Node", node.Name, "ServerClaim", client.ObjectKeyFromObject(serverClaim))
-
-	// Add label for clusterName to server claim object
-	serverClaimBase := serverClaim.DeepCopy()
-	if serverClaim.Labels == nil {
-		serverClaim.Labels = make(map[string]string)
-	}
-	serverClaim.Labels[LabelKeyClusterName] = o.cloudConfig.ClusterName
-	klog.V(2).InfoS("Adding cluster name label to server claim object", "ServerClaim", client.ObjectKeyFromObject(serverClaim), "Node", node.Name)
-	if err := o.metalClient.Patch(ctx, serverClaim, client.MergeFrom(serverClaimBase)); err != nil {
-		return nil, fmt.Errorf("failed to patch server claim for Node %s: %w", node.Name, err)
-	}
-
-	if err = o.setServerClaimPower(ctx, node, serverClaim); err != nil {
-		return nil, err
+	if serverClaim.Spec.ServerRef == nil {
+		klog.V(2).InfoS("Server claim for node is not bound", "Node", node.Name, "ServerClaim", client.ObjectKeyFromObject(serverClaim), "Phase", serverClaim.Status.Phase)
+		return nil, serverClaimNotBoundError(serverClaim)
 	}
 
 	server := &metalv1alpha1.Server{}
 	if err := o.metalClient.Get(ctx, client.ObjectKey{Name: serverClaim.Spec.ServerRef.Name}, server); err != nil {
 		return nil, fmt.Errorf("failed to get server object for node %s: %w", node.Name, err)
 	}
+	if err := verifyServerForNode(server, node); err != nil {
+		return nil, err
+	}
 
 	providerID := node.Spec.ProviderID
 	if providerID == "" {
@@ -140,14 +139,17 @@ func (o *metalInstancesV2) InstanceMetadata(ctx context.Context, node *corev1.No
 		klog.V(2).InfoS("No region label found for node instance", "Node", node.Name)
 	}
 
-	klog.V(2).InfoS("Additional labels for node instance", "Node", node.Name, "Labels", server.Labels)
+	additionalLabels := make(map[string]string, len(server.Labels))
+	maps.Copy(additionalLabels, server.Labels)
+	maps.Copy(additionalLabels, getTopologyLabels(o.cloudConfig.Topology, server))
+	klog.V(2).InfoS("Additional labels for node instance", "Node", node.Name, "Labels", additionalLabels)
 
 	metaData := &cloudprovider.InstanceMetadata{
 		ProviderID:       providerID,
 		InstanceType:     instanceType,
 		Zone:             zone,
 		Region:           region,
-		AdditionalLabels: server.Labels,
+		AdditionalLabels: additionalLabels,
 	}
 
 	if metaData.NodeAddresses, err = o.getNodeAddresses(ctx, server, serverClaim); err != nil {
@@ -221,32 +223,6 @@ func (o *metalInstancesV2) getNodeAddresses(ctx context.Context, server *metalv1
 	return nil, errors.New("unknown ipamKind used for node ip address assignment")
 }
 
-// setServerClaimPower ensures that the server claim:
-// - is powered off if the node has the powerOffAnnotation and
-// - is powered on if the node does not have the powerOffAnnotation
-// This does not guarantee that other controllers such as the
-// machine-controller-manager interfere with the power state of the server claim.
-func (o *metalInstancesV2) setServerClaimPower(ctx context.Context, node *corev1.Node, serverClaim *metalv1alpha1.ServerClaim) error {
-	_, powerOff := node.Annotations[AnnotationPowerOff]
-	if powerOff && serverClaim.Spec.Power != metalv1alpha1.PowerOff {
-		klog.InfoS("Ensuring server is powered off", "Node", node.Name)
-		serverClaimBase := serverClaim.DeepCopy()
-		serverClaim.Spec.Power = metalv1alpha1.PowerOff
-		if err := o.metalClient.Patch(ctx, serverClaim, client.MergeFrom(serverClaimBase)); err != nil {
-			return fmt.Errorf("failed to patch server claim for Node %s: %w", node.Name, err)
-		}
-	}
-	if !powerOff && serverClaim.Spec.Power == metalv1alpha1.PowerOff {
-		klog.InfoS("Ensuring server is powered on", "Node", node.Name)
-		serverClaimBase := serverClaim.DeepCopy()
-		serverClaim.Spec.Power = metalv1alpha1.PowerOn
-		if err := o.metalClient.Patch(ctx, serverClaim, client.MergeFrom(serverClaimBase)); err != nil {
-			return fmt.Errorf("failed to patch server claim for Node %s: %w", node.Name, err)
-		}
-	}
-	return nil
-}
-
 func (o *metalInstancesV2) getServerClaimForNode(ctx context.Context, node *corev1.Node) (*metalv1alpha1.ServerClaim, error) {
 	if node.Spec.ProviderID != "" {
 		return o.getServerClaimFromProviderID(ctx, node.Spec.ProviderID)
@@ -288,6 +264,12 @@ func (o *metalInstancesV2) getServerClaimFromProviderID(ctx context.Context, pro
 	return serverClaim, nil
 }
 
+// serverClaimNotBoundError is returned for a ServerClaim without a ServerRef. In contrast to
+// cloudprovider.InstanceNotFound it makes the cloud node controller retry until the claim is bound.
+func serverClaimNotBoundError(serverClaim *metalv1alpha1.ServerClaim) error {
+	return fmt.Errorf("server claim %s is not bound to a server, phase %q", client.ObjectKeyFromObject(serverClaim), serverClaim.Status.Phase)
+}
+
 func buildProviderID(namespace, name string) string {
 	u := url.URL{
 		Scheme: ProviderName,
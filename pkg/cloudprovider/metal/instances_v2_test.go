@@ -116,9 +116,10 @@ var _ = Describe("InstancesV2", func() {
 			HaveField("Zone", "a"),
 			HaveField("Region", "bar")))
 
-		By("Ensuring cluster name label is added to ServerClaim object")
-		Eventually(Object(serverClaim)).Should(SatisfyAll(
-			HaveField("Labels", map[string]string{LabelKeyClusterName: clusterName}),
+		By("Ensuring the ServerClaim object is not modified by the metadata lookup")
+		Consistently(Object(serverClaim)).Should(SatisfyAll(
+			HaveField("Labels", BeEmpty()),
+			HaveField("Spec.Power", metalv1alpha1.PowerOn),
 		))
 	})
 
@@ -190,96 +191,6 @@ var _ = Describe("InstancesV2", func() {
 		))
 	})
 
-	It("Should power off an annotated server", func(ctx SpecContext) {
-		By("Creating a Server")
-		server := &metalv1alpha1.Server{
-			ObjectMeta: metav1.ObjectMeta{
-				GenerateName: "test-",
-			},
-			Spec: metalv1alpha1.ServerSpec{
-				SystemUUID: "9876",
-				Power:      "On",
-			},
-		}
-		Expect(k8sClient.Create(ctx, server)).To(Succeed())
-		DeferCleanup(k8sClient.Delete, server)
-
-		By("Patching the Server object to have a valid network interface status")
-		Eventually(UpdateStatus(server, func() {
-			server.Status.PowerState = metalv1alpha1.ServerOnPowerState
-		})).Should(Succeed())
-
-		By("Creating a ServerClaim for a Node")
-		serverClaim := &metalv1alpha1.ServerClaim{
-			ObjectMeta: metav1.ObjectMeta{
-				Namespace:    ns.Name,
-				GenerateName: "test-",
-			},
-			Spec: metalv1alpha1.ServerClaimSpec{
-				Power:     "On",
-				ServerRef: &corev1.LocalObjectReference{Name: server.Name},
-			},
-		}
-		Expect(k8sClient.Create(ctx, serverClaim)).To(Succeed())
-		DeferCleanup(k8sClient.Delete, serverClaim)
-
-		By("Creating a Node object with a provider ID referencing the machine")
-		node := &corev1.Node{
-			ObjectMeta: metav1.ObjectMeta{
-				GenerateName: "test-",
-			},
-		}
-		Expect(k8sClient.Create(ctx, node)).To(Succeed())
-		DeferCleanup(k8sClient.Delete, node)
-
-		By("Updating the SystemUUID in Node status")
-		Eventually(UpdateStatus(node, func() {
-			node.Status.NodeInfo.SystemUUID = "9876"
-		})).Should(Succeed())
-
-		By("Ensuring that an instance for a Node exists")
-		ok, err := instancesProvider.InstanceExists(ctx, node)
-		Expect(err).NotTo(HaveOccurred())
-		Expect(ok).To(BeTrue())
-
-		By("Ensuring that the instance is not shut down")
-		ok, err = instancesProvider.InstanceShutdown(ctx, node)
-		Expect(err).NotTo(HaveOccurred())
-		Expect(ok).To(BeFalse())
-
-		By("Ensuring that the instance meta data has the correct providerID")
-		instanceMetadata, err := instancesProvider.InstanceMetadata(ctx, node)
-		Expect(err).NotTo(HaveOccurred())
-		Eventually(instanceMetadata).Should(SatisfyAll(
-			HaveField("ProviderID", buildProviderID(serverClaim.Namespace, serverClaim.Name)),
-		))
-
-		By("Ensuring cluster name label is added to ServerClaim object")
-		Eventually(Object(serverClaim)).Should(HaveField("Spec.Power", metalv1alpha1.PowerOn))
-
-		By("Annotating the node with power off")
-		Eventually(Update(node, func() {
-			node.Annotations = map[string]string{
-				AnnotationPowerOff: "true",
-			}
-		})).Should(Succeed())
-		_, err = instancesProvider.InstanceMetadata(ctx, node)
-		Expect(err).To(Succeed())
-
-		By("Ensuring the ServerClaim is updated to power off")
-		Eventually(Object(serverClaim)).Should(HaveField("Spec.Power", metalv1alpha1.PowerOff))
-
-		By("Removing the power off annotation")
-		Eventually(Update(node, func() {
-			delete(node.Annotations, AnnotationPowerOff)
-		})).Should(Succeed())
-		_, err = instancesProvider.InstanceMetadata(ctx, node)
-		Expect(err).To(Succeed())
-
-		By("Ensuring the ServerClaim is updated to power on")
-		Eventually(Object(serverClaim)).Should(HaveField("Spec.Power", metalv1alpha1.PowerOn))
-	})
-
 	It("Should get instance info for a Node with correct ProviderID", func(ctx SpecContext) {
 		By("Creating a Server")
 		server := &metalv1alpha1.Server{
@@ -415,6 +326,118 @@ var _ = Describe("InstancesV2", func() {
 		Expect(err).To(Equal(cloudprovider.InstanceNotFound))
 		Expect(ok).To(BeFalse())
 	})
+
+	It("Should fail with a retryable error if the ServerClaim is not bound", func(ctx SpecContext) {
+		By("Creating a ServerClaim without a ServerRef")
+		serverClaim := &metalv1alpha1.ServerClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-",
+			},
+			Spec: metalv1alpha1.ServerClaimSpec{
+				Power: "On",
+			},
+		}
+		Expect(k8sClient.Create(ctx, serverClaim)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, serverClaim)
+		Eventually(UpdateStatus(serverClaim, func() {
+			serverClaim.Status.Phase = metalv1alpha1.PhaseUnbound
+		})).Should(Succeed())
+
+		By("Creating a Node object with a provider ID referencing the ServerClaim")
+		node := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "test-",
+			},
+			Spec: corev1.NodeSpec{
+				ProviderID: buildProviderID(serverClaim.Namespace, serverClaim.Name),
+			},
+		}
+		Expect(k8sClient.Create(ctx, node)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, node)
+
+		By("Ensuring the instance exists but its metadata and shutdown state are not available yet")
+		ok, err := instancesProvider.InstanceExists(ctx, node)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeTrue())
+
+		metaData, err := instancesProvider.InstanceMetadata(ctx, node)
+		Expect(err).To(HaveOccurred())
+		Expect(err).NotTo(Equal(cloudprovider.InstanceNotFound))
+		Expect(metaData).To(BeNil())
+
+		ok, err = instancesProvider.InstanceShutdown(ctx, node)
+		Expect(err).To(HaveOccurred())
+		Expect(err).NotTo(Equal(cloudprovider.InstanceNotFound))
+		Expect(ok).To(BeFalse())
+
+		By("Ensuring the Node reports the unbound ServerClaim")
+		Eventually(Object(node)).Should(HaveField("Status.Conditions", ContainElement(SatisfyAll(
+			HaveField("Type", NodeConditionServerClaimBound),
+			HaveField("Status", corev1.ConditionFalse),
+			HaveField("Reason", string(metalv1alpha1.PhaseUnbound)),
+		))))
+
+		By("Binding the ServerClaim to a Server")
+		server := &metalv1alpha1.Server{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "test-",
+			},
+			Spec: metalv1alpha1.ServerSpec{
+				SystemUUID: "12345",
+			},
+		}
+		Expect(k8sClient.Create(ctx, server)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, server)
+		Eventually(Update(serverClaim, func() {
+			serverClaim.Spec.ServerRef = &corev1.LocalObjectReference{Name: server.Name}
+		})).Should(Succeed())
+
+		By("Ensuring the Node reports the bound ServerClaim")
+		Eventually(Object(node)).Should(HaveField("Status.Conditions", ContainElement(SatisfyAll(
+			HaveField("Type", NodeConditionServerClaimBound),
+			HaveField("Status", corev1.ConditionTrue),
+		))))
+	})
+
+	It("Should report a pending ServerClaim on a Node that is not registered yet", func(ctx SpecContext) {
+		By("Creating a ServerClaim without a ServerRef")
+		serverClaim := &metalv1alpha1.ServerClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-",
+			},
+			Spec: metalv1alpha1.ServerClaimSpec{
+				Power: "On",
+			},
+		}
+		Expect(k8sClient.Create(ctx, serverClaim)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, serverClaim)
+
+		By("Creating a Node object without a provider ID named after the ServerClaim")
+		node := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: serverClaim.Name,
+			},
+		}
+		Expect(k8sClient.Create(ctx, node)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, node)
+
+		By("Ensuring the ServerClaim is not matched by name alone")
+		exists, err := instancesProvider.InstanceExists(ctx, node)
+		Expect(err).To(Equal(cloudprovider.InstanceNotFound))
+		Expect(exists).To(BeFalse())
+		metaData, err := instancesProvider.InstanceMetadata(ctx, node)
+		Expect(err).To(Equal(cloudprovider.InstanceNotFound))
+		Expect(metaData).To(BeNil())
+
+		By("Ensuring the Node reports the pending ServerClaim")
+		Eventually(Object(node)).Should(HaveField("Status.Conditions", ContainElement(SatisfyAll(
+			HaveField("Type", NodeConditionServerClaimBound),
+			HaveField("Status", corev1.ConditionFalse),
+			HaveField("Reason", conditionReasonServerClaimPending),
+		))))
+	})
 })
 
 var _ = Describe("InstancesV2 with configure node addresses false", func() {
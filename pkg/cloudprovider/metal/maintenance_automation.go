@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	eventReasonMaintenanceApproved = "MaintenanceApproved"
+
+	eventActionApprove = "Approve"
+
+	conditionReasonWaitingForNodeReady = "WaitingForNodeReady"
+)
+
+// reconcileMaintenanceAutomation drains a Node whose server needs maintenance and approves the
+// maintenance once the Node is drained. After the maintenance the approval is withdrawn and the
+// Node is uncordoned. Nodes that were not drained by the automation are left alone.
+func (r *NodeReconciler) reconcileMaintenanceAutomation(ctx context.Context, node *corev1.Node) (ctrl.Result, error) {
+	maintenanceNeeded := node.Labels[metalv1alpha1.ServerMaintenanceNeededLabelKey] == TrueStr
+	if !maintenanceNeeded {
+		return ctrl.Result{}, r.finishMaintenance(ctx, node)
+	}
+	if !r.cloudConfig.MaintenanceAutomation.Enabled || hasServerMismatchTaint(node) {
+		return ctrl.Result{}, nil
+	}
+	if err := r.setMaintenanceRequestedAt(ctx, node, true); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	condition := getNodeCondition(node, NodeConditionMaintenanceDrained)
+	if condition != nil && condition.Status == corev1.ConditionTrue {
+		return ctrl.Result{}, nil
+	}
+	if condition == nil && node.Labels[metalv1alpha1.ServerMaintenanceApprovedLabelKey] == TrueStr {
+		klog.V(2).InfoS("Maintenance already approved, skipping drain", "node", node.Name)
+		return ctrl.Result{}, nil
+	}
+
+	if condition == nil {
+		open, untilOpen := r.maintenanceWindowOpen(node)
+		if !open {
+			klog.V(2).InfoS("Waiting for the maintenance window to drain Node", "node", node.Name)
+			return ctrl.Result{RequeueAfter: untilOpen}, nil
+		}
+		admitted, err := r.admitMaintenance(ctx, node)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !admitted {
+			return ctrl.Result{RequeueAfter: MaintenanceBudgetRequeueDelay}, nil
+		}
+
+		klog.InfoS("Draining Node for server maintenance", "node", node.Name)
+		r.recorder.Eventf(node, nil, corev1.EventTypeNormal, eventReasonDrainingNode, eventActionDrain,
+			"Draining Node because its server needs maintenance")
+		if _, err := patchNodeConditions(ctx, r.targetClient, node, corev1.NodeCondition{
+			Type:    NodeConditionMaintenanceDrained,
+			Status:  corev1.ConditionFalse,
+			Reason:  conditionReasonDraining,
+			Message: "Evicting pods before the server maintenance is approved",
+		}); err != nil {
+			return ctrl.Result{}, err
+		}
+		condition = getNodeCondition(node, NodeConditionMaintenanceDrained)
+	}
+	started := condition.LastTransitionTime.Time
+
+	drained, err := r.drainer.drain(ctx, node)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	reason, message := conditionReasonDrained, "All pods were evicted before the server maintenance was approved"
+	if !drained {
+		timeout := r.cloudConfig.MaintenanceAutomation.GetDrainTimeout()
+		if remaining := time.Until(started.Add(timeout)); remaining > 0 {
+			return ctrl.Result{RequeueAfter: min(DrainRequeueDelay, remaining)}, nil
+		}
+		klog.InfoS("Timed out draining Node, approving server maintenance", "node", node.Name, "timeout", timeout)
+		r.recorder.Eventf(node, nil, corev1.EventTypeWarning, eventReasonDrainTimeout, eventActionDrain,
+			"Node was not drained within %s, approving server maintenance", timeout)
+		reason, message = conditionReasonDrainTimeout, "The server maintenance was approved before all pods were evicted"
+	} else {
+		r.recorder.Eventf(node, nil, corev1.EventTypeNormal, eventReasonNodeDrained, eventActionDrain,
+			"Node drained for server maintenance")
+	}
+
+	if err := r.setMaintenanceApproval(ctx, node, true); err != nil {
+		return ctrl.Result{}, err
+	}
+	r.recorder.Eventf(node, nil, corev1.EventTypeNormal, eventReasonMaintenanceApproved, eventActionApprove,
+		"Approved server maintenance")
+	_, err = patchNodeConditions(ctx, r.targetClient, node, corev1.NodeCondition{
+		Type:    NodeConditionMaintenanceDrained,
+		Status:  corev1.ConditionTrue,
+		Reason:  reason,
+		Message: message,
+	})
+	return ctrl.Result{}, err
+}
+
+// finishMaintenance withdraws the approval of a finished maintenance that was approved by the
+// automation and uncordons the Node once it is Ready again.
+func (r *NodeReconciler) finishMaintenance(ctx context.Context, node *corev1.Node) error {
+	if err := r.setMaintenanceRequestedAt(ctx, node, false); err != nil {
+		return err
+	}
+	if getNodeCondition(node, NodeConditionMaintenanceDrained) == nil {
+		return nil
+	}
+	if err := r.setMaintenanceApproval(ctx, node, false); err != nil {
+		return err
+	}
+	if ready := getNodeCondition(node, corev1.NodeReady); ready == nil || ready.Status != corev1.ConditionTrue {
+		klog.V(2).InfoS("Waiting for Node to become Ready after maintenance", "node", node.Name)
+		_, err := patchNodeConditions(ctx, r.targetClient, node, corev1.NodeCondition{
+			Type:    NodeConditionMaintenanceDrained,
+			Status:  corev1.ConditionFalse,
+			Reason:  conditionReasonWaitingForNodeReady,
+			Message: "The server maintenance finished, waiting for the Node to become Ready",
+		})
+		return err
+	}
+	if err := r.drainer.release(ctx, node, NodeConditionMaintenanceDrained); err != nil {
+		return err
+	}
+	r.enqueueWaitingForMaintenance(ctx)
+	return nil
+}
+
+// enqueueWaitingForMaintenance enqueues the Nodes waiting for the maintenance budget, so that they
+// do not wait for their next check once a Node left the budget.
+func (r *NodeReconciler) enqueueWaitingForMaintenance(ctx context.Context) {
+	if !r.cloudConfig.MaintenanceAutomation.Budget.Limited() {
+		return
+	}
+	var nodes corev1.NodeList
+	if err := r.targetClient.List(ctx, &nodes); err != nil {
+		klog.ErrorS(err, "Failed to list nodes")
+		return
+	}
+	for i := range nodes.Items {
+		if waitsForMaintenance(&nodes.Items[i]) {
+			r.queue.Add(client.ObjectKeyFromObject(&nodes.Items[i]))
+		}
+	}
+}
+
+// setMaintenanceRequestedAt records the time the Node was first seen needing maintenance or removes it.
+func (r *NodeReconciler) setMaintenanceRequestedAt(ctx context.Context, node *corev1.Node, requested bool) error {
+	if _, ok := node.Annotations[AnnotationMaintenanceRequestedAt]; ok == requested {
+		return nil
+	}
+	base := node.DeepCopy()
+	if requested {
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationMaintenanceRequestedAt, time.Now().UTC().Format(time.RFC3339))
+	} else {
+		delete(node.Annotations, AnnotationMaintenanceRequestedAt)
+	}
+	if err := r.targetClient.Patch(ctx, node, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("unable to patch maintenance requested annotation of Node %s: %w", node.Name, err)
+	}
+	return nil
+}
+
+// setMaintenanceApproval adds or removes the maintenance approved label of the Node.
+func (r *NodeReconciler) setMaintenanceApproval(ctx context.Context, node *corev1.Node, approved bool) error {
+	if (node.Labels[metalv1alpha1.ServerMaintenanceApprovedLabelKey] == TrueStr) == approved {
+		return nil
+	}
+	base := node.DeepCopy()
+	if approved {
+		if node.Labels == nil {
+			node.Labels = make(map[string]string)
+		}
+		node.Labels[metalv1alpha1.ServerMaintenanceApprovedLabelKey] = TrueStr
+	} else {
+		delete(node.Labels, metalv1alpha1.ServerMaintenanceApprovedLabelKey)
+	}
+	if err := r.targetClient.Patch(ctx, node, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("unable to patch maintenance approved label of Node %s: %w", node.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+// admitMaintenance reports whether the maintenance of the Node may start within the maintenance
+// budget. Waiting Nodes are admitted by descending approval priority and then by the time their
+// maintenance was requested. Nodes whose maintenance window is closed do not take up the budget.
+func (r *NodeReconciler) admitMaintenance(ctx context.Context, node *corev1.Node) (bool, error) {
+	budget := r.cloudConfig.MaintenanceAutomation.Budget
+	if !budget.Limited() {
+		return true, nil
+	}
+
+	// The Nodes are listed from the API server, as a cached list may not contain the budget taken by
+	// a Node that was admitted right before.
+	var nodes corev1.NodeList
+	if err := r.targetReader.List(ctx, &nodes); err != nil {
+		return false, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	unavailable := 0
+	unavailablePerZone := map[string]int{}
+	var waiting []*corev1.Node
+	for i := range nodes.Items {
+		other := &nodes.Items[i]
+		switch {
+		case holdsMaintenanceBudget(other):
+			unavailable++
+			unavailablePerZone[other.Labels[corev1.LabelTopologyZone]]++
+		case waitsForMaintenance(other):
+			if open, _ := r.maintenanceWindowOpen(other); open {
+				waiting = append(waiting, other)
+			}
+		}
+	}
+	slices.SortFunc(waiting, compareMaintenanceRequests)
+
+	for _, candidate := range waiting {
+		if budget.MaxUnavailable > 0 && unavailable >= budget.MaxUnavailable {
+			break
+		}
+		zone := candidate.Labels[corev1.LabelTopologyZone]
+		if budget.MaxUnavailablePerZone > 0 && unavailablePerZone[zone] >= budget.MaxUnavailablePerZone {
+			continue
+		}
+		if candidate.Name == node.Name {
+			return true, nil
+		}
+		unavailable++
+		unavailablePerZone[zone]++
+	}
+	klog.V(2).InfoS("Maintenance budget exhausted, waiting", "node", node.Name, "unavailable", unavailable)
+	return false, nil
+}
+
+// holdsMaintenanceBudget reports whether the Node is drained for, held in or recovering from a
+// maintenance or a reimage.
+func holdsMaintenanceBudget(node *corev1.Node) bool {
+	if getNodeCondition(node, NodeConditionMaintenanceDrained) != nil || reimageInProgress(node) {
+		return true
+	}
+	return node.Labels[metalv1alpha1.ServerMaintenanceNeededLabelKey] == TrueStr &&
+		node.Labels[metalv1alpha1.ServerMaintenanceApprovedLabelKey] == TrueStr
+}
+
+// waitsForMaintenance reports whether the server of the Node needs a maintenance or a reimage that
+// did not start yet.
+func waitsForMaintenance(node *corev1.Node) bool {
+	return node.DeletionTimestamp.IsZero() && !hasServerMismatchTaint(node) &&
+		(node.Labels[metalv1alpha1.ServerMaintenanceNeededLabelKey] == TrueStr || reimagePending(node)) &&
+		!holdsMaintenanceBudget(node)
+}
+
+// compareMaintenanceRequests orders Nodes by descending approval priority, then by the time their
+// maintenance was requested and finally by name.
+func compareMaintenanceRequests(a, b *corev1.Node) int {
+	if c := cmp.Compare(maintenanceApprovalPriority(b), maintenanceApprovalPriority(a)); c != 0 {
+		return c
+	}
+	aRequestedAt, aOk := maintenanceRequestedAt(a)
+	bRequestedAt, bOk := maintenanceRequestedAt(b)
+	switch {
+	case aOk && !bOk:
+		return -1
+	case !aOk && bOk:
+		return 1
+	case aOk && bOk:
+		if c := aRequestedAt.Compare(bRequestedAt); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(a.Name, b.Name)
+}
+
+// maintenanceApprovalPriority returns the approval priority of the Node. Nodes without a valid
+// priority annotation have priority zero.
+func maintenanceApprovalPriority(node *corev1.Node) int {
+	priority, err := strconv.Atoi(node.Annotations[AnnotationMaintenanceApprovalPriority])
+	if err != nil {
+		return 0
+	}
+	return priority
+}
+
+// maintenanceRequestedAt returns the time the Node was first seen needing maintenance and whether
+// it is known.
+func maintenanceRequestedAt(node *corev1.Node) (time.Time, bool) {
+	requestedAt, err := time.Parse(time.RFC3339, node.Annotations[AnnotationMaintenanceRequestedAt])
+	return requestedAt, err == nil
+}
@@ -0,0 +1,387 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maxMaintenanceHistorySize bounds the data of the maintenance history ConfigMap well below the size
+// limit of a ConfigMap. The oldest records of all Nodes are dropped first.
+const maxMaintenanceHistorySize = 768 * 1024
+
+// MaintenanceOutcome is the outcome of a server maintenance.
+type MaintenanceOutcome string
+
+const (
+	// MaintenanceOutcomeCompleted is the outcome of a maintenance that was removed after the server was in maintenance.
+	MaintenanceOutcomeCompleted MaintenanceOutcome = "Completed"
+	// MaintenanceOutcomeFailed is the outcome of a maintenance that was removed after it failed.
+	MaintenanceOutcomeFailed MaintenanceOutcome = "Failed"
+	// MaintenanceOutcomeCancelled is the outcome of a maintenance that was removed before the server was in maintenance.
+	MaintenanceOutcomeCancelled MaintenanceOutcome = "Cancelled"
+)
+
+// MaintenanceRecord records a ServerMaintenance requested for a Node.
+type MaintenanceRecord struct {
+	// ServerMaintenance is the name of the ServerMaintenance in the metal namespace.
+	ServerMaintenance string    `json:"serverMaintenance"`
+	UID               types.UID `json:"uid"`
+	Server            string    `json:"server,omitempty"`
+	Reason            string    `json:"reason,omitempty"`
+	// RequestedAt is the time the ServerMaintenance was created.
+	RequestedAt metav1.Time `json:"requestedAt"`
+	// ApprovedAt is the time the maintenance approved label was first seen on the Node.
+	ApprovedAt *metav1.Time `json:"approvedAt,omitempty"`
+	// ApprovedBy is the field manager that set the maintenance approved label on the Node.
+	ApprovedBy string `json:"approvedBy,omitempty"`
+	// StartedAt is the time the server was first seen in maintenance.
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// CompletedAt is the time the ServerMaintenance was removed. It is unset while the maintenance is ongoing.
+	CompletedAt *metav1.Time       `json:"completedAt,omitempty"`
+	Outcome     MaintenanceOutcome `json:"outcome,omitempty"`
+}
+
+// GetMaintenanceHistory returns the maintenance records kept in the given namespace by Node name,
+// oldest first.
+func GetMaintenanceHistory(ctx context.Context, reader client.Reader, namespace string) (map[string][]MaintenanceRecord, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: MaintenanceHistoryConfigMapName}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return map[string][]MaintenanceRecord{}, nil
+		}
+		return nil, fmt.Errorf("unable to get maintenance history: %w", err)
+	}
+	history := make(map[string][]MaintenanceRecord, len(configMap.Data))
+	for nodeName, data := range configMap.Data {
+		var records []MaintenanceRecord
+		if err := json.Unmarshal([]byte(data), &records); err != nil {
+			return nil, fmt.Errorf("unable to decode maintenance history of Node %s: %w", nodeName, err)
+		}
+		history[nodeName] = records
+	}
+	return history, nil
+}
+
+// maintenanceHistory keeps the maintenance records of the Nodes in a ConfigMap of the target cluster.
+type maintenanceHistory struct {
+	targetClient      client.Client
+	targetReader      client.Reader
+	key               types.NamespacedName
+	maxRecordsPerNode int
+
+	// recorded holds the last recorded maintenance of each Node, so that unchanged maintenances are
+	// not read from the API server on every reconciliation.
+	recordedMu sync.Mutex
+	recorded   map[string]recordedMaintenance
+}
+
+// recordedMaintenance is the input of the last maintenance record written for a Node.
+type recordedMaintenance struct {
+	uid        types.UID
+	server     string
+	reason     string
+	approvedAt time.Time
+	approvedBy string
+	started    bool
+}
+
+// newMaintenanceHistory returns the maintenance history or nil if it is disabled. The ConfigMap is
+// read from the API server, so that the target cluster cache does not watch all ConfigMaps.
+func newMaintenanceHistory(targetClient client.Client, targetReader client.Reader, config MaintenanceHistory) *maintenanceHistory {
+	if !config.Enabled {
+		return nil
+	}
+	return &maintenanceHistory{
+		targetClient:      targetClient,
+		targetReader:      targetReader,
+		key:               types.NamespacedName{Namespace: config.GetNamespace(), Name: MaintenanceHistoryConfigMapName},
+		maxRecordsPerNode: config.GetMaxRecordsPerNode(),
+		recorded:          map[string]recordedMaintenance{},
+	}
+}
+
+// alreadyRecorded reports whether the maintenance was recorded for the Node as given.
+func (h *maintenanceHistory) alreadyRecorded(nodeName string, maintenance recordedMaintenance) bool {
+	h.recordedMu.Lock()
+	defer h.recordedMu.Unlock()
+	recorded, ok := h.recorded[nodeName]
+	return ok && recorded == maintenance
+}
+
+// setRecorded remembers the maintenance recorded for the Node or forgets it.
+func (h *maintenanceHistory) setRecorded(nodeName string, maintenance *recordedMaintenance) {
+	h.recordedMu.Lock()
+	defer h.recordedMu.Unlock()
+	if maintenance == nil {
+		delete(h.recorded, nodeName)
+		return
+	}
+	h.recorded[nodeName] = *maintenance
+}
+
+// update applies mutate to the records of the Node and stores them if they changed.
+func (h *maintenanceHistory) update(ctx context.Context, nodeName string, mutate func([]MaintenanceRecord) []MaintenanceRecord) error {
+	conflict := func(err error) bool {
+		return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+	}
+	return retry.OnError(retry.DefaultRetry, conflict, func() error {
+		configMap := &corev1.ConfigMap{}
+		exists := true
+		if err := h.targetReader.Get(ctx, h.key, configMap); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return fmt.Errorf("unable to get maintenance history: %w", err)
+			}
+			exists = false
+			configMap = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: h.key.Namespace,
+					Name:      h.key.Name,
+					Labels:    map[string]string{labelKeyManagedBy: cloudProviderMetalName},
+				},
+			}
+		}
+
+		var records []MaintenanceRecord
+		if data, ok := configMap.Data[nodeName]; ok {
+			if err := json.Unmarshal([]byte(data), &records); err != nil {
+				// An unreadable history must not block recording new maintenances.
+				klog.ErrorS(err, "Discarding unreadable maintenance history", "node", nodeName)
+				records = nil
+			}
+		}
+		records = mutate(records)
+		if excess := len(records) - h.maxRecordsPerNode; excess > 0 {
+			records = records[excess:]
+		}
+		data, err := json.Marshal(records)
+		if err != nil {
+			return fmt.Errorf("unable to encode maintenance history of Node %s: %w", nodeName, err)
+		}
+		if configMap.Data[nodeName] == string(data) {
+			return nil
+		}
+		if configMap.Data == nil {
+			configMap.Data = make(map[string]string)
+		}
+		configMap.Data[nodeName] = string(data)
+		if err := trimMaintenanceHistory(configMap.Data, maxMaintenanceHistorySize); err != nil {
+			return err
+		}
+
+		if !exists {
+			if err := h.targetClient.Create(ctx, configMap); err != nil {
+				return fmt.Errorf("unable to create maintenance history: %w", err)
+			}
+			return nil
+		}
+		if err := h.targetClient.Update(ctx, configMap); err != nil {
+			return fmt.Errorf("unable to update maintenance history: %w", err)
+		}
+		return nil
+	})
+}
+
+// trimMaintenanceHistory drops the oldest records of all Nodes until the history fits into the given
+// size. Nodes without records are removed.
+func trimMaintenanceHistory(data map[string]string, limit int) error {
+	size := 0
+	for nodeName, value := range data {
+		size += len(nodeName) + len(value)
+	}
+	if size <= limit {
+		return nil
+	}
+
+	history := make(map[string][]MaintenanceRecord, len(data))
+	for nodeName, value := range data {
+		var records []MaintenanceRecord
+		if err := json.Unmarshal([]byte(value), &records); err != nil {
+			klog.ErrorS(err, "Discarding unreadable maintenance history", "node", nodeName)
+		}
+		history[nodeName] = records
+	}
+	for size > limit {
+		oldest := ""
+		for nodeName, records := range history {
+			if len(records) == 0 {
+				oldest = nodeName
+				break
+			}
+			if oldest == "" || records[0].RequestedAt.Before(&history[oldest][0].RequestedAt) {
+				oldest = nodeName
+			}
+		}
+		size -= len(oldest) + len(data[oldest])
+		records := history[oldest]
+		if len(records) <= 1 {
+			delete(history, oldest)
+			delete(data, oldest)
+			continue
+		}
+		records = records[1:]
+		value, err := json.Marshal(records)
+		if err != nil {
+			return fmt.Errorf("unable to encode maintenance history of Node %s: %w", oldest, err)
+		}
+		history[oldest] = records
+		data[oldest] = string(value)
+		size += len(oldest) + len(value)
+	}
+	return nil
+}
+
+// recordServerMaintenance records the ServerMaintenance of the Node, its approval and the time the
+// server went into maintenance. The history is informational, so a failure to write it is logged
+// and does not hold back the maintenance. A maintenance that did not change since it was last
+// recorded is not written again.
+func (r *NodeReconciler) recordServerMaintenance(ctx context.Context, node *corev1.Node, maintenance *metalv1alpha1.ServerMaintenance, state metalv1alpha1.ServerMaintenanceState) {
+	if r.history == nil {
+		return
+	}
+	current := recordedMaintenance{
+		uid:     maintenance.UID,
+		reason:  maintenance.Annotations[metalv1alpha1.ServerMaintenanceReasonAnnotationKey],
+		started: state == metalv1alpha1.ServerMaintenanceStateInMaintenance,
+	}
+	if maintenance.Spec.ServerRef != nil {
+		current.server = maintenance.Spec.ServerRef.Name
+	}
+	if approvedAt, ok := maintenanceApprovedAt(node); ok {
+		current.approvedAt = approvedAt
+		current.approvedBy = maintenanceApprover(node)
+	}
+	if r.history.alreadyRecorded(node.Name, current) {
+		return
+	}
+
+	err := r.history.update(ctx, node.Name, func(records []MaintenanceRecord) []MaintenanceRecord {
+		i := slices.IndexFunc(records, func(record MaintenanceRecord) bool {
+			return record.UID == maintenance.UID
+		})
+		if i < 0 {
+			records = append(records, MaintenanceRecord{
+				ServerMaintenance: maintenance.Name,
+				UID:               maintenance.UID,
+				RequestedAt:       maintenance.CreationTimestamp,
+			})
+			i = len(records) - 1
+		}
+		record := &records[i]
+		record.Server = current.server
+		record.Reason = current.reason
+		if !current.approvedAt.IsZero() && record.ApprovedAt == nil {
+			approvedAt := metav1.NewTime(current.approvedAt)
+			record.ApprovedAt = &approvedAt
+			record.ApprovedBy = current.approvedBy
+		}
+		if current.started && record.StartedAt == nil {
+			now := metav1.Now()
+			record.StartedAt = &now
+		}
+		return records
+	})
+	if err != nil {
+		klog.ErrorS(err, "Failed to record server maintenance in history", "node", node.Name, "servermaintenance", client.ObjectKeyFromObject(maintenance))
+		return
+	}
+	r.history.setRecorded(node.Name, &current)
+}
+
+// completeServerMaintenanceRecord closes the ongoing maintenance records of the Node with the outcome
+// derived from the last state of its ServerMaintenance. An error is returned if the history cannot be
+// written, so that the completion is retried instead of being lost.
+func (r *NodeReconciler) completeServerMaintenanceRecord(ctx context.Context, node *corev1.Node, lastState string) error {
+	if r.history == nil {
+		return nil
+	}
+	outcome := MaintenanceOutcomeCancelled
+	switch metalv1alpha1.ServerMaintenanceState(lastState) {
+	case metalv1alpha1.ServerMaintenanceStateInMaintenance:
+		outcome = MaintenanceOutcomeCompleted
+	case metalv1alpha1.ServerMaintenanceStateFailed:
+		outcome = MaintenanceOutcomeFailed
+	}
+	now := metav1.Now()
+	err := r.history.update(ctx, node.Name, func(records []MaintenanceRecord) []MaintenanceRecord {
+		for i := range records {
+			if records[i].CompletedAt == nil {
+				records[i].CompletedAt = &now
+				records[i].Outcome = outcome
+			}
+		}
+		return records
+	})
+	if err != nil {
+		return fmt.Errorf("unable to complete server maintenance in history of Node %s: %w", node.Name, err)
+	}
+	r.history.setRecorded(node.Name, nil)
+	return nil
+}
+
+// setMaintenanceApprovedAt records the time the maintenance approved label was first seen on the
+// Node or removes it once the label is gone.
+func (r *NodeReconciler) setMaintenanceApprovedAt(ctx context.Context, node *corev1.Node) error {
+	approved := node.Labels[metalv1alpha1.ServerMaintenanceApprovedLabelKey] == TrueStr
+	if _, ok := node.Annotations[AnnotationMaintenanceApprovedAt]; ok == approved {
+		return nil
+	}
+	base := node.DeepCopy()
+	if approved {
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationMaintenanceApprovedAt, time.Now().UTC().Format(time.RFC3339))
+	} else {
+		delete(node.Annotations, AnnotationMaintenanceApprovedAt)
+	}
+	if err := r.targetClient.Patch(ctx, node, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("unable to patch maintenance approved annotation of Node %s: %w", node.Name, err)
+	}
+	return nil
+}
+
+// maintenanceApprovedAt returns the time the maintenance approval of the Node was first seen and
+// whether it is known.
+func maintenanceApprovedAt(node *corev1.Node) (time.Time, bool) {
+	if node.Labels[metalv1alpha1.ServerMaintenanceApprovedLabelKey] != TrueStr {
+		return time.Time{}, false
+	}
+	approvedAt, err := time.Parse(time.RFC3339, node.Annotations[AnnotationMaintenanceApprovedAt])
+	return approvedAt, err == nil
+}
+
+// maintenanceApprover returns the field manager that owns the maintenance approved label of the Node.
+// It is empty if no field manager is known.
+func maintenanceApprover(node *corev1.Node) string {
+	field := "f:" + metalv1alpha1.ServerMaintenanceApprovedLabelKey
+	for _, entry := range node.ManagedFields {
+		if entry.FieldsV1 == nil {
+			continue
+		}
+		var fields struct {
+			Metadata struct {
+				Labels map[string]json.RawMessage `json:"f:labels"`
+			} `json:"f:metadata"`
+		}
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+			continue
+		}
+		if _, ok := fields.Metadata.Labels[field]; ok {
+			return entry.Manager
+		}
+	}
+	return ""
+}
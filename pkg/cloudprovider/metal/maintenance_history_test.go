@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("trimMaintenanceHistory", func() {
+	encode := func(requestedAt ...time.Time) string {
+		records := make([]MaintenanceRecord, 0, len(requestedAt))
+		for _, t := range requestedAt {
+			records = append(records, MaintenanceRecord{ServerMaintenance: "maintenance", RequestedAt: metav1.NewTime(t)})
+		}
+		data, err := json.Marshal(records)
+		Expect(err).NotTo(HaveOccurred())
+		return string(data)
+	}
+	day := func(d int) time.Time {
+		return time.Date(2026, time.January, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	It("should keep the history if it fits", func() {
+		data := map[string]string{"a": encode(day(1), day(2))}
+		Expect(trimMaintenanceHistory(data, 1024)).To(Succeed())
+		Expect(data).To(HaveKeyWithValue("a", encode(day(1), day(2))))
+	})
+
+	It("should drop the oldest records of all Nodes first", func() {
+		data := map[string]string{
+			"a": encode(day(1), day(4)),
+			"b": encode(day(2), day(3)),
+		}
+		limit := len("a") + len(encode(day(4))) + len("b") + len(encode(day(3)))
+		Expect(trimMaintenanceHistory(data, limit)).To(Succeed())
+		Expect(data).To(Equal(map[string]string{
+			"a": encode(day(4)),
+			"b": encode(day(3)),
+		}))
+	})
+
+	It("should remove Nodes without records", func() {
+		data := map[string]string{
+			"a": encode(day(1)),
+			"b": encode(day(2)),
+		}
+		Expect(trimMaintenanceHistory(data, len("b")+len(encode(day(2))))).To(Succeed())
+		Expect(data).To(Equal(map[string]string{"b": encode(day(2))}))
+	})
+})
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"fmt"
+	"strconv"
+
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	eventReasonInvalidMaintenanceSettings = "InvalidMaintenanceSettings"
+
+	eventActionMaintenance = "Maintenance"
+)
+
+// serverMaintenanceSettings are the settings of the ServerMaintenance requested by a Node.
+type serverMaintenanceSettings struct {
+	policy             metalv1alpha1.ServerMaintenancePolicy
+	priority           int32
+	reason             string
+	bootImage          string
+	ignitionSecretName string
+}
+
+// validateServerMaintenancePolicy reports an error if the policy is set to an unknown value.
+func validateServerMaintenancePolicy(policy metalv1alpha1.ServerMaintenancePolicy) error {
+	switch policy {
+	case "", metalv1alpha1.ServerMaintenancePolicyOwnerApproval, metalv1alpha1.ServerMaintenancePolicyEnforced:
+		return nil
+	}
+	return fmt.Errorf("unknown policy %q, must be %s or %s", policy,
+		metalv1alpha1.ServerMaintenancePolicyOwnerApproval, metalv1alpha1.ServerMaintenancePolicyEnforced)
+}
+
+// resolveServerMaintenanceSettings applies the annotations of the Node to the configured defaults.
+// Invalid annotations are ignored and returned as problems, so that they can be reported.
+func resolveServerMaintenanceSettings(defaults ServerMaintenanceDefaults, node *corev1.Node) (serverMaintenanceSettings, []string) {
+	settings := serverMaintenanceSettings{
+		policy:             metalv1alpha1.ServerMaintenancePolicyOwnerApproval,
+		priority:           serverMaintenancePriority,
+		reason:             defaults.Reason,
+		bootImage:          defaults.BootImage,
+		ignitionSecretName: defaults.IgnitionSecretName,
+	}
+	if defaults.Policy != "" {
+		settings.policy = defaults.Policy
+	}
+	if defaults.Priority != nil {
+		settings.priority = *defaults.Priority
+	}
+
+	var problems []string
+	if policy, ok := node.Annotations[AnnotationMaintenancePolicy]; ok {
+		if err := validateServerMaintenancePolicy(metalv1alpha1.ServerMaintenancePolicy(policy)); err != nil || policy == "" {
+			problems = append(problems, fmt.Sprintf("annotation %s has unknown policy %q, must be %s or %s", AnnotationMaintenancePolicy, policy,
+				metalv1alpha1.ServerMaintenancePolicyOwnerApproval, metalv1alpha1.ServerMaintenancePolicyEnforced))
+		} else {
+			settings.policy = metalv1alpha1.ServerMaintenancePolicy(policy)
+		}
+	}
+	if value, ok := node.Annotations[AnnotationMaintenancePriority]; ok {
+		priority, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("annotation %s has invalid priority %q, must be a 32 bit integer", AnnotationMaintenancePriority, value))
+		} else {
+			settings.priority = int32(priority)
+		}
+	}
+	if reason, ok := node.Annotations[AnnotationMaintenanceReason]; ok {
+		settings.reason = reason
+	}
+	if image, ok := node.Annotations[AnnotationMaintenanceBootImage]; ok {
+		settings.bootImage = image
+	}
+	return settings, problems
+}
+
+// apply sets the settings on the ServerMaintenance of the given server.
+func (s serverMaintenanceSettings) apply(maintenance *metalv1alpha1.ServerMaintenance, serverName string) {
+	maintenance.Spec.Policy = s.policy
+	maintenance.Spec.Priority = s.priority
+
+	if s.reason != "" {
+		if maintenance.Annotations == nil {
+			maintenance.Annotations = make(map[string]string)
+		}
+		maintenance.Annotations[metalv1alpha1.ServerMaintenanceReasonAnnotationKey] = s.reason
+	} else {
+		delete(maintenance.Annotations, metalv1alpha1.ServerMaintenanceReasonAnnotationKey)
+	}
+
+	if s.bootImage == "" {
+		maintenance.Spec.ServerBootConfigurationTemplate = nil
+		return
+	}
+	template := &metalv1alpha1.ServerBootConfigurationTemplate{
+		Name: maintenance.Name,
+		Spec: metalv1alpha1.ServerBootConfigurationSpec{
+			ServerRef: corev1.LocalObjectReference{Name: serverName},
+			Image:     s.bootImage,
+		},
+	}
+	if s.ignitionSecretName != "" {
+		template.Spec.IgnitionSecretRef = &corev1.LocalObjectReference{Name: s.ignitionSecretName}
+	}
+	maintenance.Spec.ServerBootConfigurationTemplate = template
+}
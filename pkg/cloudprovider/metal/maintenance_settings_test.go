@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("resolveServerMaintenanceSettings", func() {
+	priority := int32(5)
+	defaults := ServerMaintenanceDefaults{
+		Policy:    metalv1alpha1.ServerMaintenancePolicyEnforced,
+		Priority:  &priority,
+		Reason:    "firmware rollout",
+		BootImage: "diagnostics:latest",
+	}
+	nodeWithAnnotations := func(annotations map[string]string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}}
+	}
+
+	It("should fall back to the built-in defaults", func() {
+		settings, problems := resolveServerMaintenanceSettings(ServerMaintenanceDefaults{}, nodeWithAnnotations(nil))
+		Expect(problems).To(BeEmpty())
+		Expect(settings).To(Equal(serverMaintenanceSettings{
+			policy:   metalv1alpha1.ServerMaintenancePolicyOwnerApproval,
+			priority: serverMaintenancePriority,
+		}))
+	})
+
+	It("should override the configured defaults with the Node annotations", func() {
+		settings, problems := resolveServerMaintenanceSettings(defaults, nodeWithAnnotations(map[string]string{
+			AnnotationMaintenancePolicy:    string(metalv1alpha1.ServerMaintenancePolicyOwnerApproval),
+			AnnotationMaintenancePriority:  "-3",
+			AnnotationMaintenanceReason:    "disk replacement",
+			AnnotationMaintenanceBootImage: "",
+		}))
+		Expect(problems).To(BeEmpty())
+		Expect(settings).To(Equal(serverMaintenanceSettings{
+			policy:   metalv1alpha1.ServerMaintenancePolicyOwnerApproval,
+			priority: -3,
+			reason:   "disk replacement",
+		}))
+	})
+
+	It("should report invalid Node annotations and keep the defaults", func() {
+		settings, problems := resolveServerMaintenanceSettings(defaults, nodeWithAnnotations(map[string]string{
+			AnnotationMaintenancePolicy:   "Whenever",
+			AnnotationMaintenancePriority: "high",
+		}))
+		Expect(problems).To(HaveLen(2))
+		Expect(settings.policy).To(Equal(metalv1alpha1.ServerMaintenancePolicyEnforced))
+		Expect(settings.priority).To(Equal(int32(5)))
+	})
+})
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	eventReasonServerMaintenanceStarted   = "ServerMaintenanceStarted"
+	eventReasonServerMaintenanceFailed    = "ServerMaintenanceFailed"
+	eventReasonServerMaintenanceCompleted = "ServerMaintenanceCompleted"
+
+	conditionReasonCompleted = "Completed"
+)
+
+// reconcileServerMaintenanceStatus mirrors the state of the ServerMaintenance managed for the server
+// of the Node into its annotations and the ServerMaintenance condition. Once the ServerMaintenance is
+// gone the maintenance is reported as completed.
+func (r *NodeReconciler) reconcileServerMaintenanceStatus(ctx context.Context, node *corev1.Node) error {
+	key, err := getObjectKeyFromProviderID(node.Spec.ProviderID)
+	if err != nil {
+		// The invalid providerID is already reported by reconcileMaintenance.
+		return nil
+	}
+
+	// The maintenance of a server that belongs to another cluster or to a different machine must not
+	// be reported for the Node.
+	serverClaim := &metalv1alpha1.ServerClaim{}
+	if err := r.metalClient.Get(ctx, key, serverClaim); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("unable to get ServerClaim: %w", err)
+		}
+	} else if serverClaimOwnedByOtherCluster(serverClaim, r.cloudConfig.ClusterName) {
+		klog.V(2).InfoS("ServerClaim is owned by another cluster, skipping ServerMaintenance status", "node", node.Name, "serverclaim", key)
+		return nil
+	}
+	if hasServerMismatchTaint(node) {
+		klog.V(2).InfoS("Server does not match Node, skipping ServerMaintenance status", "node", node.Name)
+		return nil
+	}
+
+	maintenance := &metalv1alpha1.ServerMaintenance{}
+	if err := r.metalClient.Get(ctx, key, maintenance); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("unable to get ServerMaintenance: %w", err)
+		}
+		maintenance = nil
+	}
+	if maintenance != nil && maintenance.Labels[labelKeyManagedBy] != cloudProviderMetalName {
+		maintenance = nil
+	}
+
+	if r.history != nil {
+		if err := r.setMaintenanceApprovedAt(ctx, node); err != nil {
+			return err
+		}
+	}
+
+	previousState := ""
+	if condition := getNodeCondition(node, NodeConditionServerMaintenance); condition != nil && condition.Status == corev1.ConditionTrue {
+		previousState = condition.Reason
+	}
+	if maintenance == nil {
+		if previousState == "" {
+			return nil
+		}
+		return r.completeServerMaintenance(ctx, node, key, previousState)
+	}
+
+	state := maintenance.Status.State
+	if state == "" {
+		state = metalv1alpha1.ServerMaintenanceStatePending
+	}
+	r.recordServerMaintenance(ctx, node, maintenance, state)
+	if err := r.setServerMaintenanceAnnotations(ctx, node, state, maintenance.Annotations[metalv1alpha1.ServerMaintenanceReasonAnnotationKey]); err != nil {
+		return err
+	}
+	if _, err := patchNodeConditions(ctx, r.targetClient, node, corev1.NodeCondition{
+		Type:    NodeConditionServerMaintenance,
+		Status:  corev1.ConditionTrue,
+		Reason:  string(state),
+		Message: fmt.Sprintf("ServerMaintenance %s is %s", key.Name, state),
+	}); err != nil {
+		return err
+	}
+	if previousState == string(state) {
+		return nil
+	}
+
+	switch state {
+	case metalv1alpha1.ServerMaintenanceStateInMaintenance:
+		klog.InfoS("Server went into maintenance", "node", node.Name, "servermaintenance", key)
+		r.recorder.Eventf(node, nil, corev1.EventTypeNormal, eventReasonServerMaintenanceStarted, eventActionMaintenance,
+			"ServerMaintenance %s started", key.Name)
+	case metalv1alpha1.ServerMaintenanceStateFailed:
+		klog.InfoS("Server maintenance failed", "node", node.Name, "servermaintenance", key)
+		r.recorder.Eventf(node, nil, corev1.EventTypeWarning, eventReasonServerMaintenanceFailed, eventActionMaintenance,
+			"ServerMaintenance %s failed", key.Name)
+	}
+	return nil
+}
+
+// completeServerMaintenance reports the maintenance of the Node as completed after its
+// ServerMaintenance was removed.
+func (r *NodeReconciler) completeServerMaintenance(ctx context.Context, node *corev1.Node, key types.NamespacedName, lastState string) error {
+	if err := r.completeServerMaintenanceRecord(ctx, node, lastState); err != nil {
+		return err
+	}
+	if err := r.setServerMaintenanceAnnotations(ctx, node, "", ""); err != nil {
+		return err
+	}
+	if _, err := patchNodeConditions(ctx, r.targetClient, node, corev1.NodeCondition{
+		Type:    NodeConditionServerMaintenance,
+		Status:  corev1.ConditionFalse,
+		Reason:  conditionReasonCompleted,
+		Message: fmt.Sprintf("ServerMaintenance %s was removed in state %s", key.Name, lastState),
+	}); err != nil {
+		return err
+	}
+	klog.InfoS("Server maintenance completed", "node", node.Name, "servermaintenance", key, "state", lastState)
+	switch metalv1alpha1.ServerMaintenanceState(lastState) {
+	case metalv1alpha1.ServerMaintenanceStateFailed:
+		r.recorder.Eventf(node, nil, corev1.EventTypeWarning, eventReasonServerMaintenanceFailed, eventActionMaintenance,
+			"ServerMaintenance %s was removed after it failed, check the server before uncordoning the Node", key.Name)
+	case metalv1alpha1.ServerMaintenanceStatePending:
+		r.recorder.Eventf(node, nil, corev1.EventTypeNormal, eventReasonServerMaintenanceCompleted, eventActionMaintenance,
+			"ServerMaintenance %s was removed before it started", key.Name)
+	default:
+		r.recorder.Eventf(node, nil, corev1.EventTypeNormal, eventReasonServerMaintenanceCompleted, eventActionMaintenance,
+			"ServerMaintenance %s completed, the Node is safe to uncordon", key.Name)
+	}
+	return nil
+}
+
+// setServerMaintenanceAnnotations publishes the state and reason of the ServerMaintenance of the Node.
+// The start time is recorded when the server first goes into maintenance. An empty state removes
+// all annotations.
+func (r *NodeReconciler) setServerMaintenanceAnnotations(ctx context.Context, node *corev1.Node, state metalv1alpha1.ServerMaintenanceState, reason string) error {
+	base := node.DeepCopy()
+	if state == "" {
+		delete(node.Annotations, AnnotationServerMaintenanceState)
+		delete(node.Annotations, AnnotationServerMaintenanceStartTime)
+		delete(node.Annotations, AnnotationServerMaintenanceReason)
+	} else {
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationServerMaintenanceState, string(state))
+		if _, ok := node.Annotations[AnnotationServerMaintenanceStartTime]; !ok && state == metalv1alpha1.ServerMaintenanceStateInMaintenance {
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationServerMaintenanceStartTime, time.Now().UTC().Format(time.RFC3339))
+		}
+		if reason != "" {
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationServerMaintenanceReason, reason)
+		} else {
+			delete(node.Annotations, AnnotationServerMaintenanceReason)
+		}
+	}
+	if maps.Equal(base.Annotations, node.Annotations) {
+		return nil
+	}
+	if err := r.targetClient.Patch(ctx, node, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("unable to patch server maintenance annotations of Node %s: %w", node.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maintenanceWindowSchedule is a parsed MaintenanceWindow.
+type maintenanceWindowSchedule struct {
+	schedule cron.Schedule
+	duration time.Duration
+	selector labels.Selector
+}
+
+// parseMaintenanceWindow parses the schedule, time zone and node selector of a MaintenanceWindow.
+func parseMaintenanceWindow(window MaintenanceWindow) (maintenanceWindowSchedule, error) {
+	location := time.UTC
+	if window.TimeZone != "" {
+		var err error
+		if location, err = time.LoadLocation(window.TimeZone); err != nil {
+			return maintenanceWindowSchedule{}, fmt.Errorf("invalid time zone %q: %w", window.TimeZone, err)
+		}
+	}
+	schedule, err := cron.ParseStandard(window.Schedule)
+	if err != nil {
+		return maintenanceWindowSchedule{}, fmt.Errorf("invalid schedule %q: %w", window.Schedule, err)
+	}
+	if specSchedule, ok := schedule.(*cron.SpecSchedule); ok {
+		specSchedule.Location = location
+	}
+	if window.Duration.Duration <= 0 {
+		return maintenanceWindowSchedule{}, fmt.Errorf("duration of schedule %q must be positive", window.Schedule)
+	}
+	selector := labels.Everything()
+	if window.NodeSelector != nil {
+		if selector, err = metav1.LabelSelectorAsSelector(window.NodeSelector); err != nil {
+			return maintenanceWindowSchedule{}, fmt.Errorf("invalid node selector of schedule %q: %w", window.Schedule, err)
+		}
+	}
+	return maintenanceWindowSchedule{
+		schedule: schedule,
+		duration: window.Duration.Duration,
+		selector: selector,
+	}, nil
+}
+
+// parseMaintenanceWindows parses the MaintenanceWindows and reports the first one that is invalid.
+func parseMaintenanceWindows(windows []MaintenanceWindow) ([]maintenanceWindowSchedule, error) {
+	schedules := make([]maintenanceWindowSchedule, 0, len(windows))
+	for i, window := range windows {
+		schedule, err := parseMaintenanceWindow(window)
+		if err != nil {
+			return nil, fmt.Errorf("maintenanceWindows[%d]: %w", i, err)
+		}
+		schedules = append(schedules, schedule)
+	}
+	return schedules, nil
+}
+
+// nextMaintenanceWindow returns the maintenance window of the Node that is open at the given time or
+// opens next. It reports false if no window applies to the Node.
+func nextMaintenanceWindow(schedules []maintenanceWindowSchedule, node *corev1.Node, now time.Time) (time.Time, time.Time, bool) {
+	var start, end time.Time
+	found := false
+	for _, schedule := range schedules {
+		if !schedule.selector.Matches(labels.Set(node.Labels)) {
+			continue
+		}
+		// A window that started less than its duration ago is still open.
+		windowStart := schedule.schedule.Next(now.Add(-schedule.duration))
+		if windowStart.IsZero() {
+			continue
+		}
+		if !found || windowStart.Before(start) {
+			start, end, found = windowStart, windowStart.Add(schedule.duration), true
+		}
+	}
+	return start, end, found
+}
+
+// maintenanceWindowOpen reports whether a maintenance window of the Node is open. If none is, it
+// returns the time until the next window opens. Nodes without a maintenance window are not restricted.
+func (r *NodeReconciler) maintenanceWindowOpen(node *corev1.Node) (bool, time.Duration) {
+	now := time.Now()
+	start, _, found := nextMaintenanceWindow(r.cloudConfig.maintenanceWindowSchedules, node, now)
+	if !found || !start.After(now) {
+		return true, 0
+	}
+	return false, start.Sub(now)
+}
+
+// reconcileMaintenanceWindowAnnotation publishes the maintenance window of the Node that is open or
+// opens next, so that its tenants can plan around it. The annotation is refreshed when the window closes.
+func (r *NodeReconciler) reconcileMaintenanceWindowAnnotation(ctx context.Context, node *corev1.Node) (ctrl.Result, error) {
+	now := time.Now()
+	start, end, found := nextMaintenanceWindow(r.cloudConfig.maintenanceWindowSchedules, node, now)
+	var result ctrl.Result
+	value := ""
+	if found {
+		value = fmt.Sprintf("%s/%s", start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339))
+		result.RequeueAfter = end.Sub(now)
+	}
+	if node.Annotations[AnnotationNextMaintenanceWindow] == value {
+		return result, nil
+	}
+
+	base := node.DeepCopy()
+	if found {
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationNextMaintenanceWindow, value)
+	} else {
+		delete(node.Annotations, AnnotationNextMaintenanceWindow)
+	}
+	if err := r.targetClient.Patch(ctx, node, client.MergeFrom(base)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to patch maintenance window annotation of Node %s: %w", node.Name, err)
+	}
+	klog.V(2).InfoS("Published next maintenance window", "node", node.Name, "window", value)
+	return result, nil
+}
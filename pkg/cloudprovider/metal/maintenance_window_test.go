@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("nextMaintenanceWindow", func() {
+	// Saturday, 2026-10-17 12:00 UTC
+	now := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"pool": "a"}}}
+	window := func(schedule string, duration time.Duration) MaintenanceWindow {
+		return MaintenanceWindow{Schedule: schedule, Duration: metav1.Duration{Duration: duration}}
+	}
+	parse := func(windows ...MaintenanceWindow) []maintenanceWindowSchedule {
+		schedules, err := parseMaintenanceWindows(windows)
+		Expect(err).NotTo(HaveOccurred())
+		return schedules
+	}
+
+	DescribeTable("should return the open or next window",
+		func(windows []MaintenanceWindow, expectedStart, expectedEnd time.Time) {
+			start, end, found := nextMaintenanceWindow(parse(windows...), node, now)
+			Expect(found).To(BeTrue())
+			Expect(start).To(BeTemporally("==", expectedStart))
+			Expect(end).To(BeTemporally("==", expectedEnd))
+		},
+		Entry("open window",
+			[]MaintenanceWindow{window("0 11 * * *", 2*time.Hour)},
+			time.Date(2026, time.October, 17, 11, 0, 0, 0, time.UTC), time.Date(2026, time.October, 17, 13, 0, 0, 0, time.UTC)),
+		Entry("next window",
+			[]MaintenanceWindow{window("0 2 * * *", time.Hour)},
+			time.Date(2026, time.October, 18, 2, 0, 0, 0, time.UTC), time.Date(2026, time.October, 18, 3, 0, 0, 0, time.UTC)),
+		Entry("earliest of several windows",
+			[]MaintenanceWindow{window("0 2 * * *", time.Hour), window("0 20 * * *", time.Hour)},
+			time.Date(2026, time.October, 17, 20, 0, 0, 0, time.UTC), time.Date(2026, time.October, 17, 21, 0, 0, 0, time.UTC)),
+		Entry("window in a time zone",
+			[]MaintenanceWindow{{Schedule: "0 2 * * *", Duration: metav1.Duration{Duration: time.Hour}, TimeZone: "Europe/Berlin"}},
+			time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC), time.Date(2026, time.October, 18, 1, 0, 0, 0, time.UTC)),
+	)
+
+	It("should skip windows whose node selector does not match", func() {
+		_, _, found := nextMaintenanceWindow(parse(MaintenanceWindow{
+			Schedule:     "0 2 * * *",
+			Duration:     metav1.Duration{Duration: time.Hour},
+			NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"pool": "b"}},
+		}), node, now)
+		Expect(found).To(BeFalse())
+	})
+
+	It("should reject invalid windows", func() {
+		for _, invalid := range []MaintenanceWindow{
+			window("not a schedule", time.Hour),
+			window("0 2 * * *", 0),
+			{Schedule: "0 2 * * *", Duration: metav1.Duration{Duration: time.Hour}, TimeZone: "Nowhere/Atlantis"},
+		} {
+			_, err := parseMaintenanceWindows([]MaintenanceWindow{invalid})
+			Expect(err).To(HaveOccurred())
+		}
+	})
+})
@@ -0,0 +1,312 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	infov1alpha1 "github.com/ironcore-dev/cloud-provider-metal/api/v1alpha1"
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlcache "sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const metalServerInfoControllerName = "metal-server-info"
+
+// MetalServerInfoReconciler maintains a MetalServerInfo for every Node with the inventory of the
+// Server behind it, so that tenants without access to the metal cluster can see which machine backs
+// a Node. Management addresses and credentials of the server and its BMC are not copied.
+type MetalServerInfoReconciler struct {
+	metalClient    client.Client
+	targetClient   client.Client
+	nodeInformer   ctrlcache.Informer
+	claimInformer  ctrlcache.Informer
+	serverInformer ctrlcache.Informer
+	bmcInformer    ctrlcache.Informer
+	clusterName    string
+	queue          workqueue.TypedRateLimitingInterface[types.NamespacedName]
+}
+
+func NewMetalServerInfoReconciler(targetClient client.Client, metalClient client.Client, nodeInformer ctrlcache.Informer, claimInformer ctrlcache.Informer, serverInformer ctrlcache.Informer, bmcInformer ctrlcache.Informer, clusterName string) MetalServerInfoReconciler {
+	rateLimiter := workqueue.NewTypedItemExponentialFailureRateLimiter[types.NamespacedName](BaseReconcilerDelay, MaxReconcilerDelay)
+	queue := workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[types.NamespacedName]{
+		Name: metalServerInfoControllerName,
+	})
+	return MetalServerInfoReconciler{
+		targetClient:   targetClient,
+		metalClient:    metalClient,
+		nodeInformer:   nodeInformer,
+		claimInformer:  claimInformer,
+		serverInformer: serverInformer,
+		bmcInformer:    bmcInformer,
+		clusterName:    clusterName,
+		queue:          queue,
+	}
+}
+
+func (r *MetalServerInfoReconciler) Start(ctx context.Context) error {
+	defer r.queue.ShutDown()
+
+	enqueueNode := func(obj any) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		node, ok := obj.(*corev1.Node)
+		if !ok {
+			klog.ErrorS(nil, "unexpected object type", "type", fmt.Sprintf("%T", obj))
+			return
+		}
+		r.queue.Add(client.ObjectKeyFromObject(node))
+	}
+	if _, err := r.nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: enqueueNode,
+		UpdateFunc: func(oldObj, newObj any) {
+			oldNode, oldOk := oldObj.(*corev1.Node)
+			newNode, newOk := newObj.(*corev1.Node)
+			if !oldOk || !newOk {
+				klog.ErrorS(nil, "unexpected object type", "type", fmt.Sprintf("%T", newObj))
+				return
+			}
+			if oldNode.Spec.ProviderID != newNode.Spec.ProviderID || hasServerMismatchTaint(oldNode) != hasServerMismatchTaint(newNode) {
+				r.queue.Add(client.ObjectKeyFromObject(newNode))
+			}
+		},
+		DeleteFunc: enqueueNode,
+	}); err != nil {
+		return fmt.Errorf("failed to add node event handler: %w", err)
+	}
+
+	enqueueClaim := func(obj any) {
+		claim, ok := obj.(*metalv1alpha1.ServerClaim)
+		if !ok {
+			klog.ErrorS(nil, "unexpected object type", "type", fmt.Sprintf("%T", obj))
+			return
+		}
+		enqueueNodesOfServerClaim(ctx, r.targetClient, r.queue, client.ObjectKeyFromObject(claim))
+	}
+	if _, err := r.claimInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: enqueueClaim,
+		UpdateFunc: func(oldObj, newObj any) {
+			enqueueClaim(newObj)
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to add server claim event handler: %w", err)
+	}
+
+	if err := addServerEventHandlers(ctx, r.targetClient, r.metalClient, r.serverInformer, r.bmcInformer, r.queue); err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			key, quit := r.queue.Get()
+			if quit {
+				return
+			}
+
+			func() {
+				defer r.queue.Done(key)
+
+				start := time.Now()
+				err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+				observeReconcile(metalServerInfoControllerName, start, err)
+				if err != nil {
+					klog.ErrorS(err, "Failed to reconcile MetalServerInfo", "node", key)
+					r.queue.AddRateLimited(key)
+					return
+				}
+
+				r.queue.Forget(key)
+			}()
+		}
+	}()
+	<-ctx.Done()
+	klog.InfoS("Stopping MetalServerInfo reconciler")
+	return nil
+}
+
+func (r *MetalServerInfoReconciler) Reconcile(ctx context.Context, req ctrl.Request) error {
+	klog.V(2).InfoS("Reconciling MetalServerInfo", "node", req.NamespacedName)
+
+	node := &corev1.Node{}
+	if err := r.targetClient.Get(ctx, req.NamespacedName, node); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		return r.deleteServerInfo(ctx, req.Name)
+	}
+	if !node.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	serverClaimKey, err := getObjectKeyFromProviderID(node.Spec.ProviderID)
+	if err != nil {
+		klog.V(2).InfoS("Node has no valid providerID, skipping MetalServerInfo", "node", node.Name)
+		return r.deleteServerInfo(ctx, node.Name)
+	}
+	// The inventory of a different machine must not be published for the Node.
+	if hasServerMismatchTaint(node) {
+		klog.V(2).InfoS("Server does not match Node, removing MetalServerInfo", "node", node.Name)
+		return r.deleteServerInfo(ctx, node.Name)
+	}
+	serverClaim := &metalv1alpha1.ServerClaim{}
+	if err := r.metalClient.Get(ctx, serverClaimKey, serverClaim); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get ServerClaim %s: %w", serverClaimKey, err)
+		}
+		return r.deleteServerInfo(ctx, node.Name)
+	}
+	// The inventory of a server claimed by another cluster must not be published either.
+	if serverClaimOwnedByOtherCluster(serverClaim, r.clusterName) {
+		klog.V(2).InfoS("ServerClaim is owned by another cluster, removing MetalServerInfo", "node", node.Name, "serverclaim", serverClaimKey)
+		return r.deleteServerInfo(ctx, node.Name)
+	}
+	if serverClaim.Spec.ServerRef == nil {
+		return r.deleteServerInfo(ctx, node.Name)
+	}
+	server := &metalv1alpha1.Server{}
+	if err := r.metalClient.Get(ctx, client.ObjectKey{Name: serverClaim.Spec.ServerRef.Name}, server); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get server %s: %w", serverClaim.Spec.ServerRef.Name, err)
+		}
+		return r.deleteServerInfo(ctx, node.Name)
+	}
+	bmc, err := r.getBMC(ctx, server)
+	if err != nil {
+		return err
+	}
+
+	status := metalServerInfoStatus(serverClaimKey, server, bmc)
+	info := &infov1alpha1.MetalServerInfo{}
+	if err := r.targetClient.Get(ctx, client.ObjectKey{Name: node.Name}, info); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get MetalServerInfo %s: %w", node.Name, err)
+		}
+		info = &infov1alpha1.MetalServerInfo{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: node.Name,
+				Labels: map[string]string{
+					labelKeyManagedBy: cloudProviderMetalName,
+				},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: corev1.SchemeGroupVersion.String(),
+					Kind:       "Node",
+					Name:       node.Name,
+					UID:        node.UID,
+				}},
+			},
+		}
+		if err := r.targetClient.Create(ctx, info); err != nil {
+			return fmt.Errorf("failed to create MetalServerInfo %s: %w", node.Name, err)
+		}
+		klog.V(2).InfoS("Created MetalServerInfo", "node", node.Name, "server", server.Name)
+	}
+
+	// LastSyncTime only changes with the inventory, so that unrelated server updates do not cause writes.
+	status.LastSyncTime = info.Status.LastSyncTime
+	if status.LastSyncTime != nil && equality.Semantic.DeepEqual(info.Status, status) {
+		return nil
+	}
+	now := metav1.Now()
+	status.LastSyncTime = &now
+	info.Status = status
+	if err := r.targetClient.Status().Update(ctx, info); err != nil {
+		return fmt.Errorf("failed to update status of MetalServerInfo %s: %w", node.Name, err)
+	}
+	return nil
+}
+
+// getBMC returns the BMC of the server, or nil if it has none.
+func (r *MetalServerInfoReconciler) getBMC(ctx context.Context, server *metalv1alpha1.Server) (*metalv1alpha1.BMC, error) {
+	if server.Spec.BMCRef == nil {
+		return nil, nil
+	}
+	bmc := &metalv1alpha1.BMC{}
+	if err := r.metalClient.Get(ctx, client.ObjectKey{Name: server.Spec.BMCRef.Name}, bmc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get BMC %s of server %s: %w", server.Spec.BMCRef.Name, server.Name, err)
+	}
+	return bmc, nil
+}
+
+// deleteServerInfo deletes the MetalServerInfo of a Node that has no server anymore.
+func (r *MetalServerInfoReconciler) deleteServerInfo(ctx context.Context, name string) error {
+	info := &infov1alpha1.MetalServerInfo{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if err := r.targetClient.Delete(ctx, info); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete MetalServerInfo %s: %w", name, err)
+	}
+	klog.V(2).InfoS("Deleted MetalServerInfo", "node", name)
+	return nil
+}
+
+// metalServerInfoStatus copies the inventory of the server and its BMC.
+func metalServerInfoStatus(serverClaimKey client.ObjectKey, server *metalv1alpha1.Server, bmc *metalv1alpha1.BMC) infov1alpha1.MetalServerInfoStatus {
+	status := infov1alpha1.MetalServerInfoStatus{
+		ServerClaim:  serverClaimKey.String(),
+		Server:       server.Name,
+		SystemUUID:   server.Spec.SystemUUID,
+		Manufacturer: server.Status.Manufacturer,
+		Model:        server.Status.Model,
+		SKU:          server.Status.SKU,
+		SerialNumber: server.Status.SerialNumber,
+		BIOSVersion:  server.Status.BIOSVersion,
+		PowerState:   string(server.Status.PowerState),
+		State:        string(server.Status.State),
+	}
+	if server.Status.TotalSystemMemory != nil {
+		memory := server.Status.TotalSystemMemory.DeepCopy()
+		status.TotalSystemMemory = &memory
+	}
+	for _, processor := range server.Status.Processors {
+		status.Processors = append(status.Processors, infov1alpha1.ProcessorInfo{
+			ID:           processor.ID,
+			Type:         processor.Type,
+			Architecture: processor.Architecture,
+			Manufacturer: processor.Manufacturer,
+			Model:        processor.Model,
+			MaxSpeedMHz:  processor.MaxSpeedMHz,
+			TotalCores:   processor.TotalCores,
+			TotalThreads: processor.TotalThreads,
+		})
+	}
+	for _, nic := range server.Status.NetworkInterfaces {
+		info := infov1alpha1.NetworkInterfaceInfo{
+			Name:          nic.Name,
+			MACAddress:    nic.MACAddress,
+			CarrierStatus: nic.CarrierStatus,
+		}
+		for _, ip := range nic.IPs {
+			info.IPs = append(info.IPs, ip.String())
+		}
+		if len(info.IPs) == 0 && nic.IP != nil {
+			info.IPs = append(info.IPs, nic.IP.String())
+		}
+		status.NetworkInterfaces = append(status.NetworkInterfaces, info)
+	}
+	if bmc != nil {
+		status.BMC = &infov1alpha1.BMCInfo{
+			Manufacturer:    bmc.Status.Manufacturer,
+			Model:           bmc.Status.Model,
+			SerialNumber:    bmc.Status.SerialNumber,
+			FirmwareVersion: bmc.Status.FirmwareVersion,
+		}
+	}
+	return status
+}
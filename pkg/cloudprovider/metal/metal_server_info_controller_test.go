@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"net/netip"
+
+	infov1alpha1 "github.com/ironcore-dev/cloud-provider-metal/api/v1alpha1"
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+)
+
+var _ = Describe("MetalServerInfoReconciler", func() {
+	ns, _, _ := SetupTest(CloudConfig{
+		ClusterName: "test-cluster",
+		ServerInfo: ServerInfo{
+			Enabled: true,
+		},
+	})
+
+	var (
+		server      *metalv1alpha1.Server
+		serverClaim *metalv1alpha1.ServerClaim
+		node        *corev1.Node
+		info        *infov1alpha1.MetalServerInfo
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		server, serverClaim, node = createRegisteredNode(ctx, ns.Name)
+		info = &infov1alpha1.MetalServerInfo{ObjectMeta: metav1.ObjectMeta{Name: node.Name}}
+		DeferCleanup(func(ctx SpecContext) error {
+			return client.IgnoreNotFound(k8sClient.Delete(ctx, info))
+		})
+
+		By("Referencing the ServerClaim from the Server")
+		Eventually(Update(server, func() {
+			server.Spec.ServerClaimRef = &metalv1alpha1.ImmutableObjectReference{
+				Namespace: serverClaim.Namespace,
+				Name:      serverClaim.Name,
+			}
+		})).Should(Succeed())
+	})
+
+	It("should mirror the inventory of the server and its BMC", func(ctx SpecContext) {
+		By("Creating a BMC for the Server")
+		bmc := &metalv1alpha1.BMC{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "test-",
+			},
+			Spec: metalv1alpha1.BMCSpec{
+				Endpoint: &metalv1alpha1.InlineEndpoint{
+					IP:         metalv1alpha1.MustParseIP("127.0.0.1"),
+					MACAddress: "aa:bb:cc:dd:ee:ff",
+				},
+				Protocol: metalv1alpha1.Protocol{
+					Name: metalv1alpha1.ProtocolRedfishLocal,
+					Port: 8000,
+				},
+				BMCSecretRef: corev1.LocalObjectReference{Name: "bmc-secret"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, bmc)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, bmc)
+		Eventually(UpdateStatus(bmc, func() {
+			bmc.Status.Manufacturer = "Contoso"
+			bmc.Status.FirmwareVersion = "1.2.3"
+			bmc.Status.IP = metalv1alpha1.MustParseIP("127.0.0.1")
+		})).Should(Succeed())
+
+		By("Reporting the inventory in the Server status")
+		Eventually(Update(server, func() {
+			server.Spec.BMCRef = &corev1.LocalObjectReference{Name: bmc.Name}
+		})).Should(Succeed())
+		memory := resource.MustParse("64Gi")
+		Eventually(UpdateStatus(server, func() {
+			server.Status.Manufacturer = "Contoso"
+			server.Status.Model = "X1"
+			server.Status.SerialNumber = "SN-1"
+			server.Status.BIOSVersion = "2.0"
+			server.Status.TotalSystemMemory = &memory
+			server.Status.Processors = []metalv1alpha1.Processor{{ID: "CPU0", TotalCores: 16}}
+			server.Status.NetworkInterfaces = []metalv1alpha1.NetworkInterface{{
+				Name:       "eth0",
+				MACAddress: "00:11:22:33:44:55",
+				IPs:        []metalv1alpha1.IP{{Addr: netip.MustParseAddr("10.0.0.1")}},
+			}}
+		})).Should(Succeed())
+
+		Eventually(Object(info)).Should(SatisfyAll(
+			HaveField("OwnerReferences", ContainElement(HaveField("UID", node.UID))),
+			HaveField("Status.ServerClaim", serverClaim.Namespace+"/"+serverClaim.Name),
+			HaveField("Status.Server", server.Name),
+			HaveField("Status.SystemUUID", server.Spec.SystemUUID),
+			HaveField("Status.Manufacturer", "Contoso"),
+			HaveField("Status.Model", "X1"),
+			HaveField("Status.SerialNumber", "SN-1"),
+			HaveField("Status.BIOSVersion", "2.0"),
+			HaveField("Status.TotalSystemMemory", HaveValue(BeComparableTo(memory))),
+			HaveField("Status.Processors", ConsistOf(HaveField("TotalCores", int32(16)))),
+			HaveField("Status.NetworkInterfaces", ConsistOf(SatisfyAll(
+				HaveField("Name", "eth0"),
+				HaveField("MACAddress", "00:11:22:33:44:55"),
+				HaveField("IPs", ConsistOf("10.0.0.1")),
+			))),
+			HaveField("Status.BMC", HaveValue(SatisfyAll(
+				HaveField("Manufacturer", "Contoso"),
+				HaveField("FirmwareVersion", "1.2.3"),
+			))),
+			HaveField("Status.LastSyncTime", Not(BeNil())),
+		))
+
+		By("Updating the BMC firmware")
+		Eventually(UpdateStatus(bmc, func() {
+			bmc.Status.FirmwareVersion = "1.2.4"
+		})).Should(Succeed())
+		Eventually(Object(info)).Should(HaveField("Status.BMC.FirmwareVersion", "1.2.4"))
+	})
+
+	It("should remove the MetalServerInfo if the server does not match the Node", func(ctx SpecContext) {
+		Eventually(Get(info)).Should(Succeed())
+
+		By("Reporting a different SystemUUID on the Node")
+		Eventually(UpdateStatus(node, func() {
+			node.Status.NodeInfo.SystemUUID = "4711"
+		})).Should(Succeed())
+		Eventually(Object(node)).Should(HaveField("Spec.Taints", ContainElement(HaveField("Key", TaintKeyServerMismatch))))
+
+		Eventually(Get(info)).Should(MatchError(apierrors.IsNotFound, "IsNotFound"))
+	})
+
+	It("should remove the MetalServerInfo if the ServerClaim belongs to another cluster", func(ctx SpecContext) {
+		Eventually(Get(info)).Should(Succeed())
+
+		By("Labelling the ServerClaim with another cluster")
+		Eventually(Update(serverClaim, func() {
+			metav1.SetMetaDataLabel(&serverClaim.ObjectMeta, LabelKeyClusterName, "other-cluster")
+		})).Should(Succeed())
+
+		Eventually(Get(info)).Should(MatchError(apierrors.IsNotFound, "IsNotFound"))
+	})
+})
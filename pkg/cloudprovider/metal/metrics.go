@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"time"
+
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+const (
+	metricsSubsystem = "cloud_provider_metal"
+
+	reconcileResultSuccess = "success"
+	reconcileResultError   = "error"
+)
+
+var (
+	reconcileTotal = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      metricsSubsystem,
+			Name:           "reconcile_total",
+			Help:           "Number of reconciliations per controller and result.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"controller", "result"},
+	)
+
+	reconcileDuration = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Subsystem:      metricsSubsystem,
+			Name:           "reconcile_duration_seconds",
+			Help:           "Duration of reconciliations per controller in seconds.",
+			Buckets:        metrics.DefBuckets,
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"controller"},
+	)
+
+	serverClaimPatchesTotal = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      metricsSubsystem,
+			Name:           "serverclaim_patches_total",
+			Help:           "Number of ServerClaim patches per controller and kind of change.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"controller", "change"},
+	)
+
+	powerActionsTotal = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      metricsSubsystem,
+			Name:           "power_actions_total",
+			Help:           "Number of handled power action requests per action and result.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"action", "result"},
+	)
+)
+
+func init() {
+	legacyregistry.MustRegister(reconcileTotal, reconcileDuration, serverClaimPatchesTotal, powerActionsTotal)
+}
+
+// observeReconcile records the result and duration of a single reconciliation.
+func observeReconcile(controller string, start time.Time, err error) {
+	result := reconcileResultSuccess
+	if err != nil {
+		result = reconcileResultError
+	}
+	reconcileTotal.WithLabelValues(controller, result).Inc()
+	reconcileDuration.WithLabelValues(controller).Observe(time.Since(start).Seconds())
+}
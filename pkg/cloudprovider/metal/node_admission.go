@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// NodeAdmissionWebhookName is the name of the validating webhook for Nodes. It is served once enabled
+	// with --webhooks and the CloudControllerManagerWebhook feature gate.
+	NodeAdmissionWebhookName = "metal-node"
+	// NodeAdmissionWebhookPath is the path the validating webhook for Nodes is served at.
+	NodeAdmissionWebhookPath = "/validate-node"
+
+	serviceAccountUsernamePrefix = "system:serviceaccount:"
+)
+
+var (
+	NodeAdmissionAllowedGroups          []string
+	NodeAdmissionAllowedServiceAccounts []string
+)
+
+var (
+	// restrictedNodeLabels and restrictedNodeAnnotations trigger actions on the hardware of a Node.
+	restrictedNodeLabels = []string{
+		metalv1alpha1.ServerMaintenanceRequestedLabelKey,
+		metalv1alpha1.ServerMaintenanceApprovedLabelKey,
+	}
+	restrictedNodeAnnotations = []string{
+		AnnotationPowerOff,
+		AnnotationPowerAction,
+		AnnotationPowerActionID,
+		AnnotationMaintenancePolicy,
+		AnnotationMaintenanceBootImage,
+		AnnotationBIOSSettings,
+		AnnotationBIOSSettingsVersion,
+		AnnotationBIOSVersion,
+		AnnotationBIOSVersionImage,
+		AnnotationReimageImage,
+		AnnotationReimageIgnitionSecret,
+	}
+)
+
+// ValidateNodeAdmission rejects changes of the maintenance and power keys of a Node by users that are
+// not allowed to make them, and malformed values of the keys interpreted by the provider. Only keys
+// that are changed are checked, so that unrelated updates of a Node are never rejected.
+func ValidateNodeAdmission(req *admissionv1.AdmissionRequest) (*admissionv1.AdmissionResponse, error) {
+	if req.Kind.Group != "" || req.Kind.Kind != "Node" ||
+		(req.Operation != admissionv1.Create && req.Operation != admissionv1.Update) {
+		return &admissionv1.AdmissionResponse{Allowed: true}, nil
+	}
+
+	var node, oldNode metav1.PartialObjectMetadata
+	if err := json.Unmarshal(req.Object.Raw, &node); err != nil {
+		return nil, fmt.Errorf("unable to decode Node: %w", err)
+	}
+	if req.Operation == admissionv1.Update {
+		if err := json.Unmarshal(req.OldObject.Raw, &oldNode); err != nil {
+			return nil, fmt.Errorf("unable to decode old Node: %w", err)
+		}
+	}
+
+	if !nodeAdmissionAllowed(req.UserInfo) {
+		var changed []string
+		for _, key := range restrictedNodeLabels {
+			if keyChanged(oldNode.Labels, node.Labels, key) {
+				changed = append(changed, "label "+key)
+			}
+		}
+		for _, key := range restrictedNodeAnnotations {
+			if keyChanged(oldNode.Annotations, node.Annotations, key) {
+				changed = append(changed, "annotation "+key)
+			}
+		}
+		if len(changed) > 0 {
+			klog.InfoS("Rejecting change of restricted Node keys", "node", node.Name, "user", req.UserInfo.Username, "keys", changed)
+			return denyNodeAdmission(http.StatusForbidden, metav1.StatusReasonForbidden,
+				fmt.Sprintf("user %s may not change the %s of Node %s", req.UserInfo.Username, strings.Join(changed, ", "), node.Name)), nil
+		}
+	}
+
+	if problems := validateNodeKeys(oldNode.ObjectMeta, node.ObjectMeta); len(problems) > 0 {
+		return denyNodeAdmission(http.StatusUnprocessableEntity, metav1.StatusReasonInvalid,
+			fmt.Sprintf("Node %s is invalid: %s", node.Name, strings.Join(problems, "; "))), nil
+	}
+	return &admissionv1.AdmissionResponse{Allowed: true}, nil
+}
+
+// nodeAdmissionAllowed reports whether the user is in an allowed group or an allowed service account.
+func nodeAdmissionAllowed(user authenticationv1.UserInfo) bool {
+	for _, group := range user.Groups {
+		if slices.Contains(NodeAdmissionAllowedGroups, group) {
+			return true
+		}
+	}
+	serviceAccount, ok := strings.CutPrefix(user.Username, serviceAccountUsernamePrefix)
+	if !ok {
+		return false
+	}
+	// Service accounts are configured as namespace/name, their usernames end in namespace:name.
+	return slices.Contains(NodeAdmissionAllowedServiceAccounts, strings.Replace(serviceAccount, ":", "/", 1))
+}
+
+// validateNodeKeys reports the malformed values of the changed labels and annotations that are
+// interpreted by the provider.
+func validateNodeKeys(old, node metav1.ObjectMeta) []string {
+	var problems []string
+	for _, key := range restrictedNodeLabels {
+		if value, ok := node.Labels[key]; ok && keyChanged(old.Labels, node.Labels, key) && value != TrueStr {
+			problems = append(problems, fmt.Sprintf("label %s must be %q", key, TrueStr))
+		}
+	}
+
+	changedAnnotation := func(key string) (string, bool) {
+		value, ok := node.Annotations[key]
+		return value, ok && keyChanged(old.Annotations, node.Annotations, key)
+	}
+	if value, ok := changedAnnotation(AnnotationPowerAction); ok {
+		switch PowerAction(value) {
+		case PowerActionReboot, PowerActionPowerCycle, PowerActionSoftOff:
+		default:
+			problems = append(problems, fmt.Sprintf("annotation %s must be %s, %s or %s", AnnotationPowerAction,
+				PowerActionReboot, PowerActionPowerCycle, PowerActionSoftOff))
+		}
+	}
+	if value, ok := changedAnnotation(AnnotationMaintenancePolicy); ok {
+		if err := validateServerMaintenancePolicy(metalv1alpha1.ServerMaintenancePolicy(value)); err != nil || value == "" {
+			problems = append(problems, fmt.Sprintf("annotation %s must be %s or %s", AnnotationMaintenancePolicy,
+				metalv1alpha1.ServerMaintenancePolicyOwnerApproval, metalv1alpha1.ServerMaintenancePolicyEnforced))
+		}
+	}
+	if value, ok := changedAnnotation(AnnotationMaintenancePriority); ok {
+		if _, err := strconv.ParseInt(value, 10, 32); err != nil {
+			problems = append(problems, fmt.Sprintf("annotation %s must be a 32 bit integer", AnnotationMaintenancePriority))
+		}
+	}
+	if value, ok := changedAnnotation(AnnotationMaintenanceApprovalPriority); ok {
+		if _, err := strconv.Atoi(value); err != nil {
+			problems = append(problems, fmt.Sprintf("annotation %s must be an integer", AnnotationMaintenanceApprovalPriority))
+		}
+	}
+	if value, ok := changedAnnotation(AnnotationBIOSSettings); ok {
+		var settings map[string]string
+		if err := json.Unmarshal([]byte(value), &settings); err != nil || len(settings) == 0 {
+			problems = append(problems, fmt.Sprintf("annotation %s must be a non-empty JSON object of string values", AnnotationBIOSSettings))
+		}
+	}
+	return problems
+}
+
+// keyChanged reports whether the key was added, removed or changed.
+func keyChanged(old, updated map[string]string, key string) bool {
+	oldValue, oldOk := old[key]
+	value, ok := updated[key]
+	return oldOk != ok || oldValue != value
+}
+
+func denyNodeAdmission(code int32, reason metav1.StatusReason, message string) *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{
+		Allowed: false,
+		Result: &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    code,
+			Reason:  reason,
+			Message: message,
+		},
+	}
+}
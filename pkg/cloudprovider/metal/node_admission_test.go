@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"encoding/json"
+	"net/http"
+
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("ValidateNodeAdmission", func() {
+	BeforeEach(func() {
+		groups, serviceAccounts := NodeAdmissionAllowedGroups, NodeAdmissionAllowedServiceAccounts
+		NodeAdmissionAllowedGroups = []string{"system:masters"}
+		NodeAdmissionAllowedServiceAccounts = []string{"kube-system/cloud-controller-manager"}
+		DeferCleanup(func() {
+			NodeAdmissionAllowedGroups, NodeAdmissionAllowedServiceAccounts = groups, serviceAccounts
+		})
+	})
+
+	admissionNode := func(labels, annotations map[string]string) runtime.RawExtension {
+		data, err := json.Marshal(&corev1.Node{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Node"},
+			ObjectMeta: metav1.ObjectMeta{Name: "node", Labels: labels, Annotations: annotations},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return runtime.RawExtension{Raw: data}
+	}
+	update := func(user authenticationv1.UserInfo, old, updated runtime.RawExtension) *admissionv1.AdmissionRequest {
+		return &admissionv1.AdmissionRequest{
+			Kind:      metav1.GroupVersionKind{Version: "v1", Kind: "Node"},
+			Operation: admissionv1.Update,
+			UserInfo:  user,
+			Object:    updated,
+			OldObject: old,
+		}
+	}
+	admin := authenticationv1.UserInfo{Username: "admin", Groups: []string{"system:masters", "system:authenticated"}}
+	ccm := authenticationv1.UserInfo{Username: "system:serviceaccount:kube-system:cloud-controller-manager"}
+	tenant := authenticationv1.UserInfo{Username: "tenant", Groups: []string{"system:authenticated"}}
+	approved := map[string]string{metalv1alpha1.ServerMaintenanceApprovedLabelKey: TrueStr}
+
+	DescribeTable("admission",
+		func(req *admissionv1.AdmissionRequest, allowed bool, code int32) {
+			response, err := ValidateNodeAdmission(req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Allowed).To(Equal(allowed))
+			if !allowed {
+				Expect(response.Result.Code).To(Equal(code))
+			}
+		},
+		Entry("an allowed group approving maintenance",
+			update(admin, admissionNode(nil, nil), admissionNode(approved, nil)), true, int32(0)),
+		Entry("an allowed service account approving maintenance",
+			update(ccm, admissionNode(nil, nil), admissionNode(approved, nil)), true, int32(0)),
+		Entry("another user approving maintenance",
+			update(tenant, admissionNode(nil, nil), admissionNode(approved, nil)), false, int32(http.StatusForbidden)),
+		Entry("another user withdrawing the approval",
+			update(tenant, admissionNode(approved, nil), admissionNode(nil, nil)), false, int32(http.StatusForbidden)),
+		Entry("another user powering off the server",
+			update(tenant, admissionNode(nil, nil), admissionNode(nil, map[string]string{AnnotationPowerOff: TrueStr})),
+			false, int32(http.StatusForbidden)),
+		Entry("another user changing the maintenance policy",
+			update(tenant, admissionNode(nil, nil), admissionNode(nil, map[string]string{AnnotationMaintenancePolicy: string(metalv1alpha1.ServerMaintenancePolicyEnforced)})),
+			false, int32(http.StatusForbidden)),
+		Entry("another user changing the maintenance boot image",
+			update(tenant, admissionNode(nil, nil), admissionNode(nil, map[string]string{AnnotationMaintenanceBootImage: "example.com/diagnostics:1.0"})),
+			false, int32(http.StatusForbidden)),
+		Entry("an allowed group changing the maintenance policy",
+			update(admin, admissionNode(nil, nil), admissionNode(nil, map[string]string{AnnotationMaintenancePolicy: string(metalv1alpha1.ServerMaintenancePolicyEnforced)})),
+			true, int32(0)),
+		Entry("another user requesting a reimage",
+			update(tenant, admissionNode(nil, nil), admissionNode(nil, map[string]string{AnnotationReimageImage: "example.com/os:2.0"})),
+			false, int32(http.StatusForbidden)),
+		Entry("another user changing unrelated keys of an approved Node",
+			update(tenant, admissionNode(approved, nil), admissionNode(approved, map[string]string{"example.com/owner": "team"})),
+			true, int32(0)),
+		Entry("a malformed maintenance label",
+			update(admin, admissionNode(nil, nil), admissionNode(map[string]string{metalv1alpha1.ServerMaintenanceRequestedLabelKey: "yes"}, nil)),
+			false, int32(http.StatusUnprocessableEntity)),
+		Entry("an unknown power action",
+			update(admin, admissionNode(nil, nil), admissionNode(nil, map[string]string{AnnotationPowerAction: "explode"})),
+			false, int32(http.StatusUnprocessableEntity)),
+		Entry("a malformed maintenance priority",
+			update(tenant, admissionNode(nil, nil), admissionNode(nil, map[string]string{AnnotationMaintenancePriority: "high"})),
+			false, int32(http.StatusUnprocessableEntity)),
+		Entry("another user requesting BIOS settings",
+			update(tenant, admissionNode(nil, nil), admissionNode(nil, map[string]string{AnnotationBIOSSettings: `{"BootMode":"UEFI"}`})),
+			false, int32(http.StatusForbidden)),
+		Entry("another user requesting a BIOS version",
+			update(tenant, admissionNode(nil, nil), admissionNode(nil, map[string]string{
+				AnnotationBIOSVersion:      "2.0",
+				AnnotationBIOSVersionImage: "example.com/bios:2.0",
+			})), false, int32(http.StatusForbidden)),
+		Entry("another user changing the version BIOS settings apply to",
+			update(tenant, admissionNode(nil, nil), admissionNode(nil, map[string]string{AnnotationBIOSSettingsVersion: "2.0"})),
+			false, int32(http.StatusForbidden)),
+		Entry("malformed BIOS settings",
+			update(admin, admissionNode(nil, nil), admissionNode(nil, map[string]string{AnnotationBIOSSettings: "BootMode=UEFI"})),
+			false, int32(http.StatusUnprocessableEntity)),
+		Entry("an unchanged malformed value",
+			update(tenant, admissionNode(nil, map[string]string{AnnotationMaintenancePriority: "high"}),
+				admissionNode(map[string]string{"example.com/owner": "team"}, map[string]string{AnnotationMaintenancePriority: "high"})),
+			true, int32(0)),
+		Entry("another user creating an approved Node",
+			&admissionv1.AdmissionRequest{
+				Kind:      metav1.GroupVersionKind{Version: "v1", Kind: "Node"},
+				Operation: admissionv1.Create,
+				UserInfo:  tenant,
+				Object:    admissionNode(approved, nil),
+			}, false, int32(http.StatusForbidden)),
+	)
+})
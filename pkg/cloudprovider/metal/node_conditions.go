@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// NodeConditionServerClaimOwned reports whether the ServerClaim of a Node belongs to this cluster
+	NodeConditionServerClaimOwned corev1.NodeConditionType = "ServerClaimOwned"
+	// NodeConditionServerClaimBound reports whether the ServerClaim of a Node is bound to a Server
+	NodeConditionServerClaimBound corev1.NodeConditionType = "ServerClaimBound"
+	// NodeConditionServerHealthy reports whether the server of a Node is healthy
+	NodeConditionServerHealthy corev1.NodeConditionType = "ServerHealthy"
+	// NodeConditionBMCReachable reports whether the BMC of the server of a Node is reachable
+	NodeConditionBMCReachable corev1.NodeConditionType = "BMCReachable"
+	// NodeConditionServerPowerState reports whether the server of a Node is powered on
+	NodeConditionServerPowerState corev1.NodeConditionType = "ServerPowerState"
+	// NodeConditionServerInMaintenance reports whether the server of a Node is in maintenance
+	NodeConditionServerInMaintenance corev1.NodeConditionType = "ServerInMaintenance"
+	// NodeConditionPowerOffDrained reports the progress of draining a Node before its server is powered off
+	NodeConditionPowerOffDrained corev1.NodeConditionType = "PowerOffDrained"
+	// NodeConditionMaintenanceDrained reports the progress of draining a Node before its server maintenance is approved
+	NodeConditionMaintenanceDrained corev1.NodeConditionType = "MaintenanceDrained"
+	// NodeConditionServerMaintenance reports the state of the ServerMaintenance requested for the server of a Node
+	NodeConditionServerMaintenance corev1.NodeConditionType = "ServerMaintenance"
+	// NodeConditionBIOSSettings reports whether the BIOS settings requested for the server of a Node are applied
+	NodeConditionBIOSSettings corev1.NodeConditionType = "BIOSSettings"
+	// NodeConditionBIOSVersion reports whether the BIOS of the server of a Node is upgraded to the requested version
+	NodeConditionBIOSVersion corev1.NodeConditionType = "BIOSVersion"
+	// NodeConditionReimage reports the progress of reprovisioning the server of a Node with a new image
+	NodeConditionReimage corev1.NodeConditionType = "Reimage"
+	// NodeConditionBootConfiguration reports the state of the ServerBootConfiguration of the ServerClaim of a Node
+	NodeConditionBootConfiguration corev1.NodeConditionType = "BootConfiguration"
+)
+
+// setNodeCondition adds or updates a condition of a Node and reports whether it changed.
+// The transition time is only bumped if the status of the condition changes.
+func setNodeCondition(node *corev1.Node, condition corev1.NodeCondition) bool {
+	now := metav1.Now()
+	for i := range node.Status.Conditions {
+		existing := &node.Status.Conditions[i]
+		if existing.Type != condition.Type {
+			continue
+		}
+		if existing.Status == condition.Status && existing.Reason == condition.Reason && existing.Message == condition.Message {
+			return false
+		}
+		if existing.Status != condition.Status {
+			existing.LastTransitionTime = now
+		}
+		existing.Status = condition.Status
+		existing.Reason = condition.Reason
+		existing.Message = condition.Message
+		existing.LastHeartbeatTime = now
+		return true
+	}
+
+	condition.LastTransitionTime = now
+	condition.LastHeartbeatTime = now
+	node.Status.Conditions = append(node.Status.Conditions, condition)
+	return true
+}
+
+// getNodeCondition returns the condition of the given type of a Node or nil if it has none.
+func getNodeCondition(node *corev1.Node, conditionType corev1.NodeConditionType) *corev1.NodeCondition {
+	for i := range node.Status.Conditions {
+		if node.Status.Conditions[i].Type == conditionType {
+			return &node.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// removeNodeCondition removes the condition of the given type from a Node and patches its status
+// if the Node had it.
+func removeNodeCondition(ctx context.Context, c client.Client, node *corev1.Node, conditionType corev1.NodeConditionType) error {
+	if getNodeCondition(node, conditionType) == nil {
+		return nil
+	}
+	base := node.DeepCopy()
+	conditions := node.Status.Conditions[:0:0]
+	for _, condition := range node.Status.Conditions {
+		if condition.Type != conditionType {
+			conditions = append(conditions, condition)
+		}
+	}
+	node.Status.Conditions = conditions
+	if err := c.Status().Patch(ctx, node, client.StrategicMergeFrom(base)); err != nil {
+		return fmt.Errorf("failed to remove condition %s of Node %s: %w", conditionType, node.Name, err)
+	}
+	return nil
+}
+
+// patchNodeConditions sets conditions on a Node and patches its status if any of them changed.
+// It reports whether a patch was sent.
+func patchNodeConditions(ctx context.Context, c client.Client, node *corev1.Node, conditions ...corev1.NodeCondition) (bool, error) {
+	base := node.DeepCopy()
+	changed := false
+	for _, condition := range conditions {
+		if setNodeCondition(node, condition) {
+			changed = true
+		}
+	}
+	if !changed {
+		return false, nil
+	}
+	if err := c.Status().Patch(ctx, node, client.StrategicMergeFrom(base)); err != nil {
+		return false, fmt.Errorf("failed to patch conditions of Node %s: %w", node.Name, err)
+	}
+	return true, nil
+}
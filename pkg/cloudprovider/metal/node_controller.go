@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"time"
 
 	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
@@ -14,6 +15,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -23,29 +25,56 @@ import (
 )
 
 const (
+	nodeControllerName = "node"
+
 	nodeMaintenanceFinalizer = "metal.ironcore.dev/cloud-provider-metal"
+	nodeReleaseFinalizer     = "metal.ironcore.dev/release-server-claim"
 
 	labelKeyManagedBy      = "app.kubernetes.io/managed-by"
 	cloudProviderMetalName = "cloud-provider-metal"
 
+	// serverMaintenancePriority is the priority of a ServerMaintenance if none is configured.
 	serverMaintenancePriority = int32(100)
+
+	eventReasonServerClaimReleased = "ServerClaimReleased"
+	eventReasonServerClaimDeleted  = "ServerClaimDeleted"
+
+	eventActionRelease = "Release"
 )
 
 type NodeReconciler struct {
-	metalClient  client.Client
-	targetClient client.Client
-	informer     ctrlcache.Informer
-	queue        workqueue.TypedRateLimitingInterface[types.NamespacedName]
+	metalClient         client.Client
+	targetClient        client.Client
+	targetReader        client.Reader
+	informer            ctrlcache.Informer
+	claimInformer       ctrlcache.Informer
+	maintenanceInformer ctrlcache.Informer
+	biosInformers       []ctrlcache.Informer
+	recorder            events.EventRecorder
+	drainer             nodeDrainer
+	history             *maintenanceHistory
+	cloudConfig         CloudConfig
+	queue               workqueue.TypedRateLimitingInterface[types.NamespacedName]
 }
 
-func NewNodeReconciler(targetClient client.Client, metalClient client.Client, nodeInformer ctrlcache.Informer) NodeReconciler {
+func NewNodeReconciler(targetClient client.Client, targetReader client.Reader, metalClient client.Client, nodeInformer ctrlcache.Informer, claimInformer ctrlcache.Informer, maintenanceInformer ctrlcache.Informer, biosInformers []ctrlcache.Informer, recorder events.EventRecorder, cloudConfig CloudConfig) NodeReconciler {
 	rateLimiter := workqueue.NewTypedItemExponentialFailureRateLimiter[types.NamespacedName](BaseReconcilerDelay, MaxReconcilerDelay)
-	queue := workqueue.NewTypedRateLimitingQueue(rateLimiter)
+	queue := workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[types.NamespacedName]{
+		Name: nodeControllerName,
+	})
 	return NodeReconciler{
-		targetClient: targetClient,
-		metalClient:  metalClient,
-		informer:     nodeInformer,
-		queue:        queue,
+		targetClient:        targetClient,
+		targetReader:        targetReader,
+		metalClient:         metalClient,
+		informer:            nodeInformer,
+		claimInformer:       claimInformer,
+		maintenanceInformer: maintenanceInformer,
+		biosInformers:       biosInformers,
+		recorder:            recorder,
+		drainer:             newNodeDrainer(targetClient, targetReader),
+		history:             newMaintenanceHistory(targetClient, targetReader, cloudConfig.MaintenanceHistory),
+		cloudConfig:         cloudConfig,
+		queue:               queue,
 	}
 }
 
@@ -85,6 +114,77 @@ func (r *NodeReconciler) Start(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to add event handler: %w", err)
 	}
+
+	// The power state requested on the Node is restored if the ServerClaim is changed directly.
+	enqueueClaim := func(obj any) {
+		claim, ok := obj.(*metalv1alpha1.ServerClaim)
+		if !ok {
+			klog.ErrorS(nil, "unexpected object type", "type", fmt.Sprintf("%T", obj))
+			return
+		}
+		enqueueNodesOfServerClaim(ctx, r.targetClient, r.queue, client.ObjectKeyFromObject(claim))
+	}
+	if _, err := r.claimInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: enqueueClaim,
+		UpdateFunc: func(oldObj, newObj any) {
+			enqueueClaim(newObj)
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to add server claim event handler: %w", err)
+	}
+
+	// The state of the ServerMaintenances managed for Nodes is mirrored onto the Nodes.
+	enqueueMaintenance := func(obj any) {
+		if deleted, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = deleted.Obj
+		}
+		maintenance, ok := obj.(*metalv1alpha1.ServerMaintenance)
+		if !ok {
+			klog.ErrorS(nil, "unexpected object type", "type", fmt.Sprintf("%T", obj))
+			return
+		}
+		if maintenance.Labels[labelKeyManagedBy] != cloudProviderMetalName {
+			return
+		}
+		// The ServerMaintenance shares its name with the ServerClaim of the Node.
+		enqueueNodesOfServerClaim(ctx, r.targetClient, r.queue, client.ObjectKeyFromObject(maintenance))
+	}
+	if _, err := r.maintenanceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: enqueueMaintenance,
+		UpdateFunc: func(oldObj, newObj any) {
+			enqueueMaintenance(newObj)
+		},
+		DeleteFunc: enqueueMaintenance,
+	}); err != nil {
+		return fmt.Errorf("failed to add server maintenance event handler: %w", err)
+	}
+
+	// The state of the BIOS objects managed for Nodes is reported on the Nodes.
+	enqueueBIOSObject := func(obj any) {
+		if deleted, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = deleted.Obj
+		}
+		biosObject, ok := obj.(client.Object)
+		if !ok {
+			klog.ErrorS(nil, "unexpected object type", "type", fmt.Sprintf("%T", obj))
+			return
+		}
+		if serverClaimKey, ok := biosServerClaimKey(biosObject); ok {
+			enqueueNodesOfServerClaim(ctx, r.targetClient, r.queue, serverClaimKey)
+		}
+	}
+	for _, informer := range r.biosInformers {
+		if _, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: enqueueBIOSObject,
+			UpdateFunc: func(oldObj, newObj any) {
+				enqueueBIOSObject(newObj)
+			},
+			DeleteFunc: enqueueBIOSObject,
+		}); err != nil {
+			return fmt.Errorf("failed to add BIOS event handler: %w", err)
+		}
+	}
+
 	go func() {
 		for {
 			key, quit := r.queue.Get()
@@ -95,13 +195,19 @@ func (r *NodeReconciler) Start(ctx context.Context) error {
 			func() {
 				defer r.queue.Done(key)
 
-				if err = r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+				start := time.Now()
+				result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+				observeReconcile(nodeControllerName, start, err)
+				if err != nil {
 					klog.ErrorS(err, "Failed to reconcile Node", "node", key)
 					r.queue.AddRateLimited(key)
 					return
 				}
 
 				r.queue.Forget(key)
+				if result.RequeueAfter > 0 {
+					r.queue.AddAfter(key, result.RequeueAfter)
+				}
 			}()
 		}
 	}()
@@ -110,35 +216,91 @@ func (r *NodeReconciler) Start(ctx context.Context) error {
 	return nil
 }
 
-func (r *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) error {
+func (r *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	klog.V(2).InfoS("Reconciling Node", "node", req.NamespacedName)
 
 	node := &corev1.Node{}
 	if err := r.targetClient.Get(ctx, req.NamespacedName, node); err != nil {
 		if client.IgnoreNotFound(err) != nil {
-			return err
+			return ctrl.Result{}, err
 		}
 		klog.V(2).InfoS("Node not found, skipping reconciliation", "node", req.NamespacedName)
-		return nil
+		return ctrl.Result{}, nil
 	}
 
 	if !node.DeletionTimestamp.IsZero() {
 		klog.V(2).InfoS("Node is being deleted, reconciling delete flow", "node", req.NamespacedName)
-		return r.reconcileDelete(ctx, node)
+		return ctrl.Result{}, r.reconcileDelete(ctx, node)
+	}
+
+	if err := r.reconcileReleaseFinalizer(ctx, node); err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to reconcile release finalizer: %w", err)
 	}
 
 	if err := r.reconcilePodCIDR(ctx, node); err != nil {
-		return fmt.Errorf("unable to reconcile PodCIDR: %w", err)
+		return ctrl.Result{}, fmt.Errorf("unable to reconcile PodCIDR: %w", err)
 	}
 
-	if err := r.reconcileMaintenance(ctx, node); err != nil {
-		return fmt.Errorf("unable to reconcile maintenance: %w", err)
+	automationResult, err := r.reconcileMaintenanceAutomation(ctx, node)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to reconcile maintenance automation: %w", err)
 	}
 
-	return nil
+	maintenanceResult, err := r.reconcileMaintenance(ctx, node)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to reconcile maintenance: %w", err)
+	}
+
+	if err := r.reconcileServerMaintenanceStatus(ctx, node); err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to reconcile ServerMaintenance status: %w", err)
+	}
+
+	if err := r.reconcileBIOS(ctx, node); err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to reconcile BIOS: %w", err)
+	}
+
+	reimageResult, err := r.reconcileReimage(ctx, node)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to reconcile reimage: %w", err)
+	}
+
+	windowResult, err := r.reconcileMaintenanceWindowAnnotation(ctx, node)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to reconcile maintenance window: %w", err)
+	}
+
+	powerResult, err := r.reconcilePower(ctx, node)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to reconcile power: %w", err)
+	}
+
+	return earliestRequeue(automationResult, maintenanceResult, reimageResult, windowResult, powerResult), nil
+}
+
+// earliestRequeue combines the results of several reconciliation steps into the one that requeues first.
+func earliestRequeue(results ...ctrl.Result) ctrl.Result {
+	var earliest ctrl.Result
+	for _, result := range results {
+		if result.RequeueAfter > 0 && (earliest.RequeueAfter == 0 || result.RequeueAfter < earliest.RequeueAfter) {
+			earliest = result
+		}
+	}
+	return earliest
 }
 
 func (r *NodeReconciler) reconcileDelete(ctx context.Context, node *corev1.Node) error {
+	if controllerutil.ContainsFinalizer(node, nodeReleaseFinalizer) {
+		if err := r.releaseServerClaim(ctx, node); err != nil {
+			return fmt.Errorf("unable to release ServerClaim: %w", err)
+		}
+
+		base := node.DeepCopy()
+		controllerutil.RemoveFinalizer(node, nodeReleaseFinalizer)
+		if err := r.targetClient.Patch(ctx, node, client.MergeFrom(base)); err != nil {
+			return fmt.Errorf("unable to remove finalizer: %w", err)
+		}
+	}
+
 	if !controllerutil.ContainsFinalizer(node, nodeMaintenanceFinalizer) {
 		return nil
 	}
@@ -171,6 +333,74 @@ func (r *NodeReconciler) reconcileDelete(ctx context.Context, node *corev1.Node)
 	return nil
 }
 
+// reconcileReleaseFinalizer keeps the release finalizer on registered Nodes as long as
+// a node deletion behaviour is configured.
+func (r *NodeReconciler) reconcileReleaseFinalizer(ctx context.Context, node *corev1.Node) error {
+	_, err := getObjectKeyFromProviderID(node.Spec.ProviderID)
+	shouldHaveFinalizer := err == nil && r.cloudConfig.NodeDeletion.Enabled()
+
+	base := node.DeepCopy()
+	var changed bool
+	if shouldHaveFinalizer {
+		changed = controllerutil.AddFinalizer(node, nodeReleaseFinalizer)
+	} else {
+		changed = controllerutil.RemoveFinalizer(node, nodeReleaseFinalizer)
+	}
+	if !changed {
+		return nil
+	}
+
+	if err := r.targetClient.Patch(ctx, node, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("unable to patch finalizer: %w", err)
+	}
+	return nil
+}
+
+// releaseServerClaim applies the configured node deletion behaviour to the ServerClaim of a Node.
+// Claims that are gone or not labelled for this cluster are left alone to not block the deletion.
+func (r *NodeReconciler) releaseServerClaim(ctx context.Context, node *corev1.Node) error {
+	serverClaimKey, err := getObjectKeyFromProviderID(node.Spec.ProviderID)
+	if err != nil {
+		klog.ErrorS(err, "Node has empty/invalid spec.providerID during node deletion. Skipping ServerClaim release to unblock node deletion", "node", node.Name)
+		return nil
+	}
+
+	serverClaim := &metalv1alpha1.ServerClaim{}
+	if err := r.metalClient.Get(ctx, serverClaimKey, serverClaim); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if owner := serverClaim.Labels[LabelKeyClusterName]; owner != r.cloudConfig.ClusterName {
+		klog.InfoS("ServerClaim is not owned by this cluster, skipping release", "serverclaim", serverClaimKey, "owner", owner)
+		return nil
+	}
+
+	nodeDeletion := r.cloudConfig.NodeDeletion
+	if nodeDeletion.DeleteServerClaim {
+		klog.InfoS("Deleting ServerClaim of deleted Node", "node", node.Name, "serverclaim", serverClaimKey)
+		if err := r.metalClient.Delete(ctx, serverClaim); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		r.recorder.Eventf(node, nil, corev1.EventTypeNormal, eventReasonServerClaimDeleted, eventActionRelease,
+			"Deleted ServerClaim %s", serverClaimKey)
+		return nil
+	}
+
+	base := serverClaim.DeepCopy()
+	if nodeDeletion.RemoveClusterLabel {
+		delete(serverClaim.Labels, LabelKeyClusterName)
+	}
+	if nodeDeletion.PowerOff && !hasServerMismatchTaint(node) {
+		serverClaim.Spec.Power = metalv1alpha1.PowerOff
+	}
+	klog.InfoS("Releasing ServerClaim of deleted Node", "node", node.Name, "serverclaim", serverClaimKey)
+	if err := r.metalClient.Patch(ctx, serverClaim, client.MergeFrom(base)); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	r.recorder.Eventf(node, nil, corev1.EventTypeNormal, eventReasonServerClaimReleased, eventActionRelease,
+		"Released ServerClaim %s", serverClaimKey)
+	return nil
+}
+
 func (r *NodeReconciler) ensureServerMaintenanceNotExists(ctx context.Context, key types.NamespacedName) error {
 	maintenance := &metalv1alpha1.ServerMaintenance{}
 	if err := r.metalClient.Get(ctx, key, maintenance); err != nil {
@@ -241,11 +471,31 @@ func zeroHostBits(ip net.IP, maskSize int) net.IP {
 	return ip.Mask(mask)
 }
 
-func (r *NodeReconciler) reconcileMaintenance(ctx context.Context, node *corev1.Node) error {
+func (r *NodeReconciler) reconcileMaintenance(ctx context.Context, node *corev1.Node) (ctrl.Result, error) {
 	serverClaimKey, err := getObjectKeyFromProviderID(node.Spec.ProviderID)
 	if err != nil {
 		klog.ErrorS(err, "Node has invalid spec.providerID", "node", node.Name, "providerID", node.Spec.ProviderID)
-		return nil
+		return ctrl.Result{}, nil
+	}
+
+	serverClaim := &metalv1alpha1.ServerClaim{}
+	if err = r.metalClient.Get(ctx, serverClaimKey, serverClaim); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("unable to get ServerClaim: %w", err)
+		}
+		serverClaim = nil
+	}
+
+	// The ServerMaintenance shares its name with the ServerClaim, so a claim owned by another
+	// cluster must neither get a maintenance nor lose the one its owner created.
+	if serverClaim != nil && serverClaimOwnedByOtherCluster(serverClaim, r.cloudConfig.ClusterName) {
+		klog.InfoS("ServerClaim is owned by another cluster, skipping maintenance logic", "serverclaim", serverClaimKey, "owner", serverClaim.Labels[LabelKeyClusterName])
+		return ctrl.Result{}, nil
+	}
+
+	if hasServerMismatchTaint(node) {
+		klog.InfoS("Server does not match Node, skipping maintenance logic", "node", node.Name, "serverclaim", serverClaimKey)
+		return ctrl.Result{}, nil
 	}
 
 	maintenanceKey := serverClaimKey
@@ -253,43 +503,39 @@ func (r *NodeReconciler) reconcileMaintenance(ctx context.Context, node *corev1.
 
 	if !maintenanceRequested {
 		if err = r.ensureServerMaintenanceNotExists(ctx, maintenanceKey); err != nil {
-			return fmt.Errorf("unable to ensure ServerMaintenance CR not exists: %w", err)
+			return ctrl.Result{}, fmt.Errorf("unable to ensure ServerMaintenance CR not exists: %w", err)
 		}
 
 		base := node.DeepCopy()
 		if removed := controllerutil.RemoveFinalizer(node, nodeMaintenanceFinalizer); removed {
 			if err = r.targetClient.Patch(ctx, node, client.MergeFrom(base)); err != nil {
-				return fmt.Errorf("unable to remove finalizer: %w", err)
+				return ctrl.Result{}, fmt.Errorf("unable to remove finalizer: %w", err)
 			}
 		}
 	}
 
-	serverClaim := &metalv1alpha1.ServerClaim{}
-	if err = r.metalClient.Get(ctx, serverClaimKey, serverClaim); err != nil {
-		if apierrors.IsNotFound(err) {
-			klog.V(2).InfoS("ServerClaim not found, skipping maintenance creation and handshake", "serverclaim", serverClaimKey)
-			return nil
-		}
-		return fmt.Errorf("unable to get ServerClaim: %w", err)
+	if serverClaim == nil {
+		klog.V(2).InfoS("ServerClaim not found, skipping maintenance creation and handshake", "serverclaim", serverClaimKey)
+		return ctrl.Result{}, nil
 	}
 
 	if serverClaim.Spec.ServerRef == nil {
 		klog.V(2).InfoS("ServerClaim has empty ServerRef, skipping maintenance logic", "serverclaim", serverClaimKey)
-		return nil
+		return ctrl.Result{}, nil
 	}
 
 	if maintenanceRequested {
 		base := node.DeepCopy()
 		if added := controllerutil.AddFinalizer(node, nodeMaintenanceFinalizer); added {
 			if err := r.targetClient.Patch(ctx, node, client.MergeFrom(base)); err != nil {
-				return fmt.Errorf("unable to add finalizer: %w", err)
+				return ctrl.Result{}, fmt.Errorf("unable to add finalizer: %w", err)
 			}
 		}
 
 		serverName := serverClaim.Spec.ServerRef.Name
 
-		if err = r.ensureServerMaintenanceExists(ctx, maintenanceKey, serverName); err != nil {
-			return fmt.Errorf("unable to ensure ServerMaintenance CR exists: %w", err)
+		if err = r.ensureServerMaintenanceExists(ctx, node, maintenanceKey, serverName); err != nil {
+			return ctrl.Result{}, fmt.Errorf("unable to ensure ServerMaintenance CR exists: %w", err)
 		}
 	}
 
@@ -299,16 +545,26 @@ func (r *NodeReconciler) reconcileMaintenance(ctx context.Context, node *corev1.
 	shouldHaveApproval := maintenanceNeeded && maintenanceApproved
 	hasApproval := serverClaim.Labels[metalv1alpha1.ServerMaintenanceApprovedLabelKey] == TrueStr
 
+	if shouldHaveApproval && !hasApproval {
+		open, untilOpen := r.maintenanceWindowOpen(node)
+		if !open {
+			klog.V(2).InfoS("Holding back maintenance approval until the maintenance window opens", "node", node.Name, "serverclaim", serverClaimKey)
+			return ctrl.Result{RequeueAfter: untilOpen}, nil
+		}
+	}
+
 	if shouldHaveApproval != hasApproval {
 		if err = r.syncServerClaimApproval(ctx, serverClaim, shouldHaveApproval); err != nil {
-			return fmt.Errorf("unable to sync ServerClaim approval: %w", err)
+			return ctrl.Result{}, fmt.Errorf("unable to sync ServerClaim approval: %w", err)
 		}
 	}
 
-	return nil
+	return ctrl.Result{}, nil
 }
 
-func (r *NodeReconciler) ensureServerMaintenanceExists(ctx context.Context, key types.NamespacedName, serverName string) error {
+func (r *NodeReconciler) ensureServerMaintenanceExists(ctx context.Context, node *corev1.Node, key types.NamespacedName, serverName string) error {
+	settings, problems := resolveServerMaintenanceSettings(r.cloudConfig.ServerMaintenance, node)
+
 	maintenance := &metalv1alpha1.ServerMaintenance{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      key.Name,
@@ -324,8 +580,7 @@ func (r *NodeReconciler) ensureServerMaintenanceExists(ctx context.Context, key
 			}
 		}
 
-		maintenance.Spec.Policy = metalv1alpha1.ServerMaintenancePolicyOwnerApproval
-		maintenance.Spec.Priority = serverMaintenancePriority
+		settings.apply(maintenance, serverName)
 		maintenance.Spec.ServerRef = &corev1.LocalObjectReference{
 			Name: serverName,
 		}
@@ -338,6 +593,13 @@ func (r *NodeReconciler) ensureServerMaintenanceExists(ctx context.Context, key
 		return nil
 	})
 
+	// Invalid annotations are reported as long as they are present, even if the defaults they fell
+	// back to did not change the ServerMaintenance. Repeated Events are aggregated by the recorder.
+	for _, problem := range problems {
+		r.recorder.Eventf(node, nil, corev1.EventTypeWarning, eventReasonInvalidMaintenanceSettings, eventActionMaintenance,
+			"Ignoring %s for ServerMaintenance %s", problem, key)
+	}
+
 	// Ignore AlreadyExists errors caused by informer cache delays.
 	// Adding a finalizer to the Node earlier in the Reconcile loop triggers an
 	// immediate re-reconciliation. This second run often happens so fast that the
@@ -4,7 +4,9 @@
 package metal
 
 import (
+	"fmt"
 	"net"
+	"time"
 
 	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
@@ -12,6 +14,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
 )
@@ -401,6 +404,35 @@ var _ = Describe("NodeReconciler", func() {
 			Consistently(Object(unownedCR)).Should(HaveField("Labels", HaveKeyWithValue(labelKeyManagedBy, managedBy)))
 		})
 
+		It("should NOT create a ServerMaintenance CR for a ServerClaim owned by another cluster", func(ctx SpecContext) {
+			By("Labelling the ServerClaim for another cluster")
+			Eventually(Update(serverClaim, func() {
+				if serverClaim.Labels == nil {
+					serverClaim.Labels = make(map[string]string)
+				}
+				serverClaim.Labels[LabelKeyClusterName] = "other-cluster"
+			})).Should(Succeed())
+
+			By("Adding the maintenance-requested label to the Node")
+			Eventually(Update(node, func() {
+				if node.Labels == nil {
+					node.Labels = make(map[string]string)
+				}
+				node.Labels[metalv1alpha1.ServerMaintenanceRequestedLabelKey] = TrueStr
+			})).Should(Succeed())
+
+			maintenanceCR := &metalv1alpha1.ServerMaintenance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      serverClaim.Name,
+					Namespace: serverClaim.Namespace,
+				},
+			}
+
+			By("Ensuring the ServerMaintenance CR is not created")
+			Consistently(Get(maintenanceCR)).Should(MatchError(apierrors.IsNotFound, "IsNotFound"))
+			Consistently(Object(node)).ShouldNot(HaveField("Finalizers", ContainElement(nodeMaintenanceFinalizer)))
+		})
+
 		It("should clean up ServerMaintenance and finalizer even if ServerClaim is deleted", func(ctx SpecContext) {
 			By("1. Triggering maintenance to create CR and add finalizer")
 			Eventually(Update(node, func() {
@@ -533,3 +565,1000 @@ var _ = Describe("zeroHostBits", func() {
 		Entry("mask /0", "2001:db8::1", 0, "::"),
 	)
 })
+
+var _ = Describe("NodeReconciler with node deletion", func() {
+	Context("releasing the ServerClaim", func() {
+		ns, _, clusterName := SetupTest(CloudConfig{
+			ClusterName: "test-cluster",
+			NodeDeletion: NodeDeletion{
+				RemoveClusterLabel: true,
+				PowerOff:           true,
+			},
+		})
+
+		It("should remove the cluster name label and power off the ServerClaim", func(ctx SpecContext) {
+			_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+
+			By("Waiting for the ServerClaim to be adopted and the Node to be protected")
+			Eventually(Object(serverClaim)).Should(HaveField("Labels", HaveKeyWithValue(LabelKeyClusterName, clusterName)))
+			Eventually(Object(node)).Should(HaveField("Finalizers", ContainElement(nodeReleaseFinalizer)))
+
+			By("Deleting the Node")
+			Expect(k8sClient.Delete(ctx, node)).To(Succeed())
+
+			By("Verifying the ServerClaim is released")
+			Eventually(Object(serverClaim)).Should(SatisfyAll(
+				HaveField("Labels", Not(HaveKey(LabelKeyClusterName))),
+				HaveField("Spec.Power", metalv1alpha1.PowerOff),
+			))
+			Eventually(Get(node)).Should(MatchError(apierrors.IsNotFound, "IsNotFound"))
+
+			By("Ensuring the ServerClaim is not adopted again")
+			Consistently(Object(serverClaim)).Should(HaveField("Labels", Not(HaveKey(LabelKeyClusterName))))
+		})
+
+		It("should not release a ServerClaim owned by another cluster", func(ctx SpecContext) {
+			_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+			Eventually(Object(node)).Should(HaveField("Finalizers", ContainElement(nodeReleaseFinalizer)))
+
+			By("Labelling the ServerClaim for another cluster")
+			Eventually(Update(serverClaim, func() {
+				if serverClaim.Labels == nil {
+					serverClaim.Labels = make(map[string]string)
+				}
+				serverClaim.Labels[LabelKeyClusterName] = "other-cluster"
+			})).Should(Succeed())
+
+			By("Deleting the Node")
+			Expect(k8sClient.Delete(ctx, node)).To(Succeed())
+			Eventually(Get(node)).Should(MatchError(apierrors.IsNotFound, "IsNotFound"))
+
+			By("Verifying the ServerClaim is untouched")
+			Consistently(Object(serverClaim)).Should(SatisfyAll(
+				HaveField("Labels", HaveKeyWithValue(LabelKeyClusterName, "other-cluster")),
+				HaveField("Spec.Power", metalv1alpha1.PowerOn),
+			))
+		})
+	})
+
+	Context("deleting the ServerClaim", func() {
+		ns, _, clusterName := SetupTest(CloudConfig{
+			ClusterName: "test-cluster",
+			NodeDeletion: NodeDeletion{
+				DeleteServerClaim: true,
+			},
+		})
+
+		It("should delete the ServerClaim", func(ctx SpecContext) {
+			_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+			Eventually(Object(serverClaim)).Should(HaveField("Labels", HaveKeyWithValue(LabelKeyClusterName, clusterName)))
+			Eventually(Object(node)).Should(HaveField("Finalizers", ContainElement(nodeReleaseFinalizer)))
+
+			By("Deleting the Node")
+			Expect(k8sClient.Delete(ctx, node)).To(Succeed())
+
+			By("Verifying the ServerClaim is deleted")
+			Eventually(Get(serverClaim)).Should(MatchError(apierrors.IsNotFound, "IsNotFound"))
+			Eventually(Get(node)).Should(MatchError(apierrors.IsNotFound, "IsNotFound"))
+		})
+	})
+
+	Context("without node deletion behaviour", func() {
+		ns, _, _ := SetupTest(CloudConfig{
+			ClusterName: "test-cluster",
+		})
+
+		It("should not protect the Node with the release finalizer", func(ctx SpecContext) {
+			_, _, node := createRegisteredNode(ctx, ns.Name)
+			Consistently(Object(node)).ShouldNot(HaveField("Finalizers", ContainElement(nodeReleaseFinalizer)))
+		})
+	})
+})
+
+var _ = Describe("NodeReconciler with power control", func() {
+	Context("without drain before power off", func() {
+		ns, _, _ := SetupTest(CloudConfig{
+			ClusterName: "test-cluster",
+		})
+
+		It("should power off an annotated server", func(ctx SpecContext) {
+			_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+
+			By("Annotating the node with power off")
+			Eventually(Update(node, func() {
+				node.Annotations = map[string]string{
+					AnnotationPowerOff: "true",
+				}
+			})).Should(Succeed())
+
+			By("Ensuring the ServerClaim is updated to power off")
+			Eventually(Object(serverClaim)).Should(HaveField("Spec.Power", metalv1alpha1.PowerOff))
+
+			By("Removing the power off annotation")
+			Eventually(Update(node, func() {
+				delete(node.Annotations, AnnotationPowerOff)
+			})).Should(Succeed())
+
+			By("Ensuring the ServerClaim is updated to power on")
+			Eventually(Object(serverClaim)).Should(HaveField("Spec.Power", metalv1alpha1.PowerOn))
+		})
+
+		It("should restore the requested power state if the ServerClaim is changed", func(ctx SpecContext) {
+			_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+
+			By("Annotating the node with power off")
+			Eventually(Update(node, func() {
+				node.Annotations = map[string]string{
+					AnnotationPowerOff: "true",
+				}
+			})).Should(Succeed())
+			Eventually(Object(serverClaim)).Should(HaveField("Spec.Power", metalv1alpha1.PowerOff))
+
+			By("Powering on the ServerClaim directly")
+			Eventually(Update(serverClaim, func() {
+				serverClaim.Spec.Power = metalv1alpha1.PowerOn
+			})).Should(Succeed())
+
+			By("Ensuring the ServerClaim is powered off again")
+			Eventually(Object(serverClaim)).Should(HaveField("Spec.Power", metalv1alpha1.PowerOff))
+		})
+	})
+
+	Context("with drain before power off", func() {
+		ns, _, _ := SetupTest(CloudConfig{
+			ClusterName: "test-cluster",
+			PowerOff: PowerOff{
+				Drain:        true,
+				GracePeriod:  metav1.Duration{Duration: 2 * time.Second},
+				DrainTimeout: metav1.Duration{Duration: 3 * time.Second},
+			},
+		})
+
+		It("should drain the Node before powering off the server", func(ctx SpecContext) {
+			_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+
+			By("Annotating the Node with power off")
+			Eventually(Update(node, func() {
+				metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationPowerOff, TrueStr)
+			})).Should(Succeed())
+
+			By("Ensuring the Node is drained and the server keeps running for the grace period")
+			Eventually(Object(node)).Should(SatisfyAll(
+				HaveField("Spec.Unschedulable", BeTrue()),
+				haveNodeCondition(NodeConditionPowerOffDrained, corev1.ConditionTrue, conditionReasonDrained),
+			))
+			Consistently(Object(serverClaim)).Should(HaveField("Spec.Power", metalv1alpha1.PowerOn))
+
+			By("Ensuring the server is powered off after the grace period")
+			Eventually(Object(serverClaim)).Should(HaveField("Spec.Power", metalv1alpha1.PowerOff))
+
+			By("Removing the power off annotation")
+			Eventually(Update(node, func() {
+				delete(node.Annotations, AnnotationPowerOff)
+			})).Should(Succeed())
+
+			By("Ensuring the Node is uncordoned and the server powered on")
+			Eventually(Object(node)).Should(SatisfyAll(
+				HaveField("Spec.Unschedulable", BeFalse()),
+				HaveField("Status.Conditions", Not(ContainElement(HaveField("Type", NodeConditionPowerOffDrained)))),
+			))
+			Eventually(Object(serverClaim)).Should(HaveField("Spec.Power", metalv1alpha1.PowerOn))
+		})
+
+		It("should keep a Node cordoned that was cordoned before the drain", func(ctx SpecContext) {
+			_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+
+			By("Cordoning the Node and annotating it with power off")
+			Eventually(Update(node, func() {
+				node.Spec.Unschedulable = true
+				metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationPowerOff, TrueStr)
+			})).Should(Succeed())
+			Eventually(Object(node)).Should(haveNodeCondition(NodeConditionPowerOffDrained, corev1.ConditionTrue, conditionReasonDrained))
+			Eventually(Object(serverClaim)).Should(HaveField("Spec.Power", metalv1alpha1.PowerOff))
+
+			By("Removing the power off annotation")
+			Eventually(Update(node, func() {
+				delete(node.Annotations, AnnotationPowerOff)
+			})).Should(Succeed())
+
+			By("Ensuring the Node stays cordoned")
+			Eventually(Object(node)).Should(HaveField("Status.Conditions", Not(ContainElement(HaveField("Type", NodeConditionPowerOffDrained)))))
+			Eventually(Object(serverClaim)).Should(HaveField("Spec.Power", metalv1alpha1.PowerOn))
+			Consistently(Object(node)).Should(SatisfyAll(
+				HaveField("Spec.Unschedulable", BeTrue()),
+				HaveField("Annotations", Not(HaveKey(AnnotationDrainCordoned))),
+			))
+		})
+
+		It("should power off the server once the drain timed out", func(ctx SpecContext) {
+			_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+
+			By("Creating a pod on the Node")
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "test-",
+				},
+				Spec: corev1.PodSpec{
+					NodeName:   node.Name,
+					Containers: []corev1.Container{{Name: "test", Image: "test"}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, pod)).To(Succeed())
+			DeferCleanup(func(ctx SpecContext) error {
+				return client.IgnoreNotFound(k8sClient.Delete(ctx, pod, client.GracePeriodSeconds(0)))
+			})
+
+			By("Annotating the Node with power off")
+			Eventually(Update(node, func() {
+				metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationPowerOff, TrueStr)
+			})).Should(Succeed())
+
+			By("Ensuring the pod is evicted while the server stays powered on")
+			Eventually(Object(node)).Should(haveNodeCondition(NodeConditionPowerOffDrained, corev1.ConditionFalse, conditionReasonDraining))
+			Eventually(Object(pod)).Should(HaveField("DeletionTimestamp", Not(BeNil())))
+			Expect(Object(serverClaim)()).To(HaveField("Spec.Power", metalv1alpha1.PowerOn))
+
+			By("Ensuring the server is powered off once the drain timed out and the grace period expired")
+			Eventually(Object(serverClaim)).WithTimeout(2*time.Second + eventuallyTimeout).Should(HaveField("Spec.Power", metalv1alpha1.PowerOff))
+			Expect(Object(node)()).To(haveNodeCondition(NodeConditionPowerOffDrained, corev1.ConditionTrue, conditionReasonDrainTimeout))
+		})
+	})
+})
+
+var _ = Describe("NodeReconciler with maintenance automation", func() {
+	ns, _, _ := SetupTest(CloudConfig{
+		ClusterName: "test-cluster",
+		MaintenanceAutomation: MaintenanceAutomation{
+			Enabled: true,
+		},
+	})
+
+	It("should drain the Node, approve the maintenance and uncordon the Node afterwards", func(ctx SpecContext) {
+		_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+
+		By("Marking the ServerClaim as needing maintenance")
+		Eventually(Update(serverClaim, func() {
+			metav1.SetMetaDataLabel(&serverClaim.ObjectMeta, metalv1alpha1.ServerMaintenanceNeededLabelKey, TrueStr)
+		})).Should(Succeed())
+
+		By("Ensuring the Node is drained and the maintenance approved")
+		Eventually(Object(node)).Should(SatisfyAll(
+			HaveField("Spec.Unschedulable", BeTrue()),
+			HaveField("Labels", HaveKeyWithValue(metalv1alpha1.ServerMaintenanceApprovedLabelKey, TrueStr)),
+			haveNodeCondition(NodeConditionMaintenanceDrained, corev1.ConditionTrue, conditionReasonDrained),
+		))
+		Eventually(Object(serverClaim)).Should(HaveField("Labels", HaveKeyWithValue(metalv1alpha1.ServerMaintenanceApprovedLabelKey, TrueStr)))
+
+		By("Finishing the maintenance")
+		Eventually(Update(serverClaim, func() {
+			delete(serverClaim.Labels, metalv1alpha1.ServerMaintenanceNeededLabelKey)
+		})).Should(Succeed())
+
+		By("Ensuring the approval is withdrawn and the Node waits to become Ready")
+		Eventually(Object(node)).Should(SatisfyAll(
+			HaveField("Spec.Unschedulable", BeTrue()),
+			HaveField("Labels", Not(HaveKey(metalv1alpha1.ServerMaintenanceApprovedLabelKey))),
+			haveNodeCondition(NodeConditionMaintenanceDrained, corev1.ConditionFalse, conditionReasonWaitingForNodeReady),
+		))
+
+		By("Reporting the Node as Ready")
+		setNodeReady(node)
+
+		By("Ensuring the Node is uncordoned")
+		Eventually(Object(node)).Should(SatisfyAll(
+			HaveField("Spec.Unschedulable", BeFalse()),
+			HaveField("Labels", Not(HaveKey(metalv1alpha1.ServerMaintenanceApprovedLabelKey))),
+			HaveField("Status.Conditions", Not(ContainElement(HaveField("Type", NodeConditionMaintenanceDrained)))),
+		))
+		Eventually(Object(serverClaim)).Should(HaveField("Labels", Not(HaveKey(metalv1alpha1.ServerMaintenanceApprovedLabelKey))))
+	})
+})
+
+var _ = Describe("NodeReconciler with maintenance automation and a drain timeout", func() {
+	ns, _, _ := SetupTest(CloudConfig{
+		ClusterName: "test-cluster",
+		MaintenanceAutomation: MaintenanceAutomation{
+			Enabled:      true,
+			DrainTimeout: metav1.Duration{Duration: 2 * time.Second},
+		},
+	})
+
+	It("should approve the maintenance once the drain timed out", func(ctx SpecContext) {
+		_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+
+		By("Creating a pod on the Node")
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-",
+			},
+			Spec: corev1.PodSpec{
+				NodeName:   node.Name,
+				Containers: []corev1.Container{{Name: "test", Image: "test"}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, pod)).To(Succeed())
+		DeferCleanup(func(ctx SpecContext) error {
+			return client.IgnoreNotFound(k8sClient.Delete(ctx, pod, client.GracePeriodSeconds(0)))
+		})
+
+		By("Marking the ServerClaim as needing maintenance")
+		Eventually(Update(serverClaim, func() {
+			metav1.SetMetaDataLabel(&serverClaim.ObjectMeta, metalv1alpha1.ServerMaintenanceNeededLabelKey, TrueStr)
+		})).Should(Succeed())
+
+		By("Ensuring the pod is evicted while the maintenance is not approved yet")
+		Eventually(Object(node)).Should(haveNodeCondition(NodeConditionMaintenanceDrained, corev1.ConditionFalse, conditionReasonDraining))
+		Eventually(Object(pod)).Should(HaveField("DeletionTimestamp", Not(BeNil())))
+		Expect(Object(node)()).To(HaveField("Labels", Not(HaveKey(metalv1alpha1.ServerMaintenanceApprovedLabelKey))))
+
+		By("Ensuring the maintenance is approved once the drain timed out")
+		Eventually(Object(node)).Should(SatisfyAll(
+			HaveField("Labels", HaveKeyWithValue(metalv1alpha1.ServerMaintenanceApprovedLabelKey, TrueStr)),
+			haveNodeCondition(NodeConditionMaintenanceDrained, corev1.ConditionTrue, conditionReasonDrainTimeout),
+		))
+	})
+})
+
+var _ = Describe("NodeReconciler with maintenance budget", func() {
+	ns, _, _ := SetupTest(CloudConfig{
+		ClusterName: "test-cluster",
+		MaintenanceAutomation: MaintenanceAutomation{
+			Enabled: true,
+			Budget: MaintenanceBudget{
+				MaxUnavailable: 1,
+			},
+		},
+	})
+
+	It("should approve one Node at a time", func(ctx SpecContext) {
+		_, firstClaim, firstNode := createRegisteredNode(ctx, ns.Name)
+		_, secondClaim, secondNode := createRegisteredNode(ctx, ns.Name)
+
+		By("Marking the first ServerClaim as needing maintenance")
+		Eventually(Update(firstClaim, func() {
+			metav1.SetMetaDataLabel(&firstClaim.ObjectMeta, metalv1alpha1.ServerMaintenanceNeededLabelKey, TrueStr)
+		})).Should(Succeed())
+		Eventually(Object(firstNode)).Should(HaveField("Labels", HaveKeyWithValue(metalv1alpha1.ServerMaintenanceApprovedLabelKey, TrueStr)))
+
+		By("Marking the second ServerClaim as needing maintenance")
+		Eventually(Update(secondClaim, func() {
+			metav1.SetMetaDataLabel(&secondClaim.ObjectMeta, metalv1alpha1.ServerMaintenanceNeededLabelKey, TrueStr)
+		})).Should(Succeed())
+
+		By("Ensuring the second Node waits for the budget")
+		Eventually(Object(secondNode)).Should(HaveField("Annotations", HaveKey(AnnotationMaintenanceRequestedAt)))
+		Consistently(Object(secondNode)).Should(SatisfyAll(
+			HaveField("Spec.Unschedulable", BeFalse()),
+			HaveField("Labels", Not(HaveKey(metalv1alpha1.ServerMaintenanceApprovedLabelKey))),
+		))
+
+		By("Finishing the maintenance of the first Node")
+		Eventually(Update(firstClaim, func() {
+			delete(firstClaim.Labels, metalv1alpha1.ServerMaintenanceNeededLabelKey)
+		})).Should(Succeed())
+		Eventually(Object(firstNode)).Should(haveNodeCondition(NodeConditionMaintenanceDrained, corev1.ConditionFalse, conditionReasonWaitingForNodeReady))
+		Consistently(Object(secondNode)).Should(HaveField("Labels", Not(HaveKey(metalv1alpha1.ServerMaintenanceApprovedLabelKey))))
+
+		By("Reporting the first Node as Ready")
+		setNodeReady(firstNode)
+
+		By("Ensuring the second Node is approved next")
+		Eventually(Object(secondNode)).Should(HaveField("Labels", HaveKeyWithValue(metalv1alpha1.ServerMaintenanceApprovedLabelKey, TrueStr)))
+	})
+})
+
+var _ = DescribeTable("compareMaintenanceRequests",
+	func(a, b *corev1.Node, expected int) {
+		Expect(compareMaintenanceRequests(a, b)).To(Equal(expected))
+	},
+	Entry("higher priority first",
+		maintenanceRequestNode("a", "10", "2026-01-02T00:00:00Z"), maintenanceRequestNode("b", "", "2026-01-01T00:00:00Z"), -1),
+	Entry("older request first",
+		maintenanceRequestNode("b", "", "2026-01-01T00:00:00Z"), maintenanceRequestNode("a", "", "2026-01-02T00:00:00Z"), -1),
+	Entry("known request time first",
+		maintenanceRequestNode("b", "", "2026-01-01T00:00:00Z"), maintenanceRequestNode("a", "", ""), -1),
+	Entry("invalid priority as zero",
+		maintenanceRequestNode("a", "high", ""), maintenanceRequestNode("b", "1", ""), 1),
+	Entry("name as tie breaker",
+		maintenanceRequestNode("a", "", ""), maintenanceRequestNode("b", "", ""), -1),
+)
+
+func maintenanceRequestNode(name, priority, requestedAt string) *corev1.Node {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: map[string]string{}}}
+	if priority != "" {
+		node.Annotations[AnnotationMaintenanceApprovalPriority] = priority
+	}
+	if requestedAt != "" {
+		node.Annotations[AnnotationMaintenanceRequestedAt] = requestedAt
+	}
+	return node
+}
+
+func setNodeReady(node *corev1.Node) {
+	GinkgoHelper()
+	Eventually(UpdateStatus(node, func() {
+		node.Status.Conditions = append(node.Status.Conditions, corev1.NodeCondition{
+			Type:   corev1.NodeReady,
+			Status: corev1.ConditionTrue,
+			Reason: "KubeletReady",
+		})
+	})).Should(Succeed())
+}
+
+var _ = Describe("NodeReconciler with maintenance windows", func() {
+	ns, _, _ := SetupTest(CloudConfig{
+		ClusterName: "test-cluster",
+		MaintenanceWindows: []MaintenanceWindow{{
+			Schedule: fmt.Sprintf("0 0 1 %d *", time.Now().AddDate(0, 6, 0).Month()),
+			Duration: metav1.Duration{Duration: time.Minute},
+		}},
+	})
+
+	It("should hold back the approval until the maintenance window opens", func(ctx SpecContext) {
+		_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+
+		By("Publishing the next maintenance window on the Node")
+		Eventually(Object(node)).Should(HaveField("Annotations", HaveKey(AnnotationNextMaintenanceWindow)))
+
+		By("Requesting and approving maintenance")
+		Eventually(Update(serverClaim, func() {
+			metav1.SetMetaDataLabel(&serverClaim.ObjectMeta, metalv1alpha1.ServerMaintenanceNeededLabelKey, TrueStr)
+		})).Should(Succeed())
+		Eventually(Object(node)).Should(HaveField("Labels", HaveKeyWithValue(metalv1alpha1.ServerMaintenanceNeededLabelKey, TrueStr)))
+		Eventually(Update(node, func() {
+			metav1.SetMetaDataLabel(&node.ObjectMeta, metalv1alpha1.ServerMaintenanceApprovedLabelKey, TrueStr)
+		})).Should(Succeed())
+
+		By("Ensuring the approval is not synced to the ServerClaim")
+		Consistently(Object(serverClaim)).Should(HaveField("Labels", Not(HaveKey(metalv1alpha1.ServerMaintenanceApprovedLabelKey))))
+	})
+})
+
+var _ = Describe("NodeReconciler with maintenance windows and budget", func() {
+	ns, _, _ := SetupTest(CloudConfig{
+		ClusterName: "test-cluster",
+		MaintenanceAutomation: MaintenanceAutomation{
+			Enabled: true,
+			Budget: MaintenanceBudget{
+				MaxUnavailable: 1,
+			},
+		},
+		MaintenanceWindows: []MaintenanceWindow{{
+			Schedule: fmt.Sprintf("0 0 1 %d *", time.Now().AddDate(0, 6, 0).Month()),
+			Duration: metav1.Duration{Duration: time.Minute},
+			NodeSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"maintenance-window": "closed"},
+			},
+		}},
+	})
+
+	It("should not hold the budget for a Node whose maintenance window is closed", func(ctx SpecContext) {
+		_, closedClaim, closedNode := createRegisteredNode(ctx, ns.Name)
+		_, openClaim, openNode := createRegisteredNode(ctx, ns.Name)
+
+		By("Requesting maintenance for a Node with a closed window and a higher priority")
+		Eventually(Update(closedNode, func() {
+			metav1.SetMetaDataLabel(&closedNode.ObjectMeta, "maintenance-window", "closed")
+			metav1.SetMetaDataAnnotation(&closedNode.ObjectMeta, AnnotationMaintenanceApprovalPriority, "10")
+		})).Should(Succeed())
+		Eventually(Update(closedClaim, func() {
+			metav1.SetMetaDataLabel(&closedClaim.ObjectMeta, metalv1alpha1.ServerMaintenanceNeededLabelKey, TrueStr)
+		})).Should(Succeed())
+		Eventually(Object(closedNode)).Should(HaveField("Annotations", HaveKey(AnnotationMaintenanceRequestedAt)))
+
+		By("Requesting maintenance for a Node without a window")
+		Eventually(Update(openClaim, func() {
+			metav1.SetMetaDataLabel(&openClaim.ObjectMeta, metalv1alpha1.ServerMaintenanceNeededLabelKey, TrueStr)
+		})).Should(Succeed())
+
+		By("Ensuring the Node without a window is approved within the budget")
+		Eventually(Object(openNode)).Should(SatisfyAll(
+			HaveField("Labels", HaveKeyWithValue(metalv1alpha1.ServerMaintenanceApprovedLabelKey, TrueStr)),
+			haveNodeCondition(NodeConditionMaintenanceDrained, corev1.ConditionTrue, conditionReasonDrained),
+		))
+		Consistently(Object(closedNode)).Should(SatisfyAll(
+			HaveField("Spec.Unschedulable", BeFalse()),
+			HaveField("Labels", Not(HaveKey(metalv1alpha1.ServerMaintenanceApprovedLabelKey))),
+		))
+	})
+})
+
+var _ = Describe("NodeReconciler with ServerMaintenance defaults", func() {
+	ns, _, _ := SetupTest(CloudConfig{
+		ClusterName: "test-cluster",
+		ServerMaintenance: ServerMaintenanceDefaults{
+			Policy:             metalv1alpha1.ServerMaintenancePolicyEnforced,
+			Reason:             "firmware rollout",
+			BootImage:          "diagnostics:latest",
+			IgnitionSecretName: "diagnostics-ignition",
+		},
+	})
+
+	It("should create the ServerMaintenance with the defaults and Node overrides", func(ctx SpecContext) {
+		_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+
+		By("Requesting maintenance with a priority override")
+		Eventually(Update(node, func() {
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationMaintenancePriority, "7")
+			metav1.SetMetaDataLabel(&node.ObjectMeta, metalv1alpha1.ServerMaintenanceRequestedLabelKey, TrueStr)
+		})).Should(Succeed())
+
+		By("Verifying the ServerMaintenance settings")
+		maintenance := &metalv1alpha1.ServerMaintenance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      serverClaim.Name,
+				Namespace: serverClaim.Namespace,
+			},
+		}
+		Eventually(Object(maintenance)).Should(SatisfyAll(
+			HaveField("Spec.Policy", metalv1alpha1.ServerMaintenancePolicyEnforced),
+			HaveField("Spec.Priority", int32(7)),
+			HaveField("Annotations", HaveKeyWithValue(metalv1alpha1.ServerMaintenanceReasonAnnotationKey, "firmware rollout")),
+			HaveField("Spec.ServerBootConfigurationTemplate.Spec", SatisfyAll(
+				HaveField("ServerRef.Name", serverClaim.Spec.ServerRef.Name),
+				HaveField("Image", "diagnostics:latest"),
+				HaveField("IgnitionSecretRef.Name", "diagnostics-ignition"),
+			)),
+		))
+	})
+})
+
+var _ = Describe("NodeReconciler with ServerMaintenance status", func() {
+	ns, _, _ := SetupTest(CloudConfig{
+		ClusterName: "test-cluster",
+		ServerMaintenance: ServerMaintenanceDefaults{
+			Reason: "firmware rollout",
+		},
+	})
+
+	It("should mirror the ServerMaintenance state onto the Node until it completed", func(ctx SpecContext) {
+		_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+
+		By("Requesting maintenance")
+		Eventually(Update(node, func() {
+			metav1.SetMetaDataLabel(&node.ObjectMeta, metalv1alpha1.ServerMaintenanceRequestedLabelKey, TrueStr)
+		})).Should(Succeed())
+		Eventually(Object(node)).Should(SatisfyAll(
+			haveNodeCondition(NodeConditionServerMaintenance, corev1.ConditionTrue, string(metalv1alpha1.ServerMaintenanceStatePending)),
+			HaveField("Annotations", SatisfyAll(
+				HaveKeyWithValue(AnnotationServerMaintenanceState, string(metalv1alpha1.ServerMaintenanceStatePending)),
+				HaveKeyWithValue(AnnotationServerMaintenanceReason, "firmware rollout"),
+				Not(HaveKey(AnnotationServerMaintenanceStartTime)),
+			)),
+		))
+
+		By("Putting the server into maintenance")
+		maintenance := &metalv1alpha1.ServerMaintenance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      serverClaim.Name,
+				Namespace: serverClaim.Namespace,
+			},
+		}
+		Eventually(UpdateStatus(maintenance, func() {
+			maintenance.Status.State = metalv1alpha1.ServerMaintenanceStateInMaintenance
+		})).Should(Succeed())
+		Eventually(Object(node)).Should(SatisfyAll(
+			haveNodeCondition(NodeConditionServerMaintenance, corev1.ConditionTrue, string(metalv1alpha1.ServerMaintenanceStateInMaintenance)),
+			HaveField("Annotations", SatisfyAll(
+				HaveKeyWithValue(AnnotationServerMaintenanceState, string(metalv1alpha1.ServerMaintenanceStateInMaintenance)),
+				HaveKey(AnnotationServerMaintenanceStartTime),
+			)),
+		))
+
+		By("Completing the maintenance")
+		Eventually(Update(node, func() {
+			delete(node.Labels, metalv1alpha1.ServerMaintenanceRequestedLabelKey)
+		})).Should(Succeed())
+		Eventually(Object(node)).Should(SatisfyAll(
+			haveNodeCondition(NodeConditionServerMaintenance, corev1.ConditionFalse, conditionReasonCompleted),
+			HaveField("Annotations", SatisfyAll(
+				Not(HaveKey(AnnotationServerMaintenanceState)),
+				Not(HaveKey(AnnotationServerMaintenanceStartTime)),
+				Not(HaveKey(AnnotationServerMaintenanceReason)),
+			)),
+		))
+	})
+
+	It("should not complete the maintenance of a ServerClaim owned by another cluster", func(ctx SpecContext) {
+		_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+
+		By("Requesting maintenance")
+		Eventually(Update(node, func() {
+			metav1.SetMetaDataLabel(&node.ObjectMeta, metalv1alpha1.ServerMaintenanceRequestedLabelKey, TrueStr)
+		})).Should(Succeed())
+		Eventually(Object(node)).Should(haveNodeCondition(NodeConditionServerMaintenance, corev1.ConditionTrue, string(metalv1alpha1.ServerMaintenanceStatePending)))
+
+		By("Labelling the ServerClaim for another cluster and removing its ServerMaintenance")
+		Eventually(Update(serverClaim, func() {
+			metav1.SetMetaDataLabel(&serverClaim.ObjectMeta, LabelKeyClusterName, "other-cluster")
+		})).Should(Succeed())
+		maintenance := &metalv1alpha1.ServerMaintenance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      serverClaim.Name,
+				Namespace: serverClaim.Namespace,
+			},
+		}
+		Expect(k8sClient.Delete(ctx, maintenance)).To(Succeed())
+
+		By("Ensuring the maintenance is not reported as completed")
+		Consistently(Object(node)).Should(haveNodeCondition(NodeConditionServerMaintenance, corev1.ConditionTrue, string(metalv1alpha1.ServerMaintenanceStatePending)))
+	})
+})
+
+var _ = Describe("NodeReconciler with maintenance history", func() {
+	ns, _, _ := SetupTest(CloudConfig{
+		ClusterName: "test-cluster",
+		MaintenanceHistory: MaintenanceHistory{
+			Enabled: true,
+		},
+	})
+
+	It("should record the request, approval and completion of a maintenance", func(ctx SpecContext) {
+		_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+
+		By("Requesting and approving maintenance")
+		Eventually(Update(node, func() {
+			metav1.SetMetaDataLabel(&node.ObjectMeta, metalv1alpha1.ServerMaintenanceRequestedLabelKey, TrueStr)
+			metav1.SetMetaDataLabel(&node.ObjectMeta, metalv1alpha1.ServerMaintenanceApprovedLabelKey, TrueStr)
+		})).Should(Succeed())
+		maintenance := &metalv1alpha1.ServerMaintenance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      serverClaim.Name,
+				Namespace: serverClaim.Namespace,
+			},
+		}
+		Eventually(UpdateStatus(maintenance, func() {
+			maintenance.Status.State = metalv1alpha1.ServerMaintenanceStateInMaintenance
+		})).Should(Succeed())
+
+		nodeHistory := func(ctx SpecContext) ([]MaintenanceRecord, error) {
+			history, err := GetMaintenanceHistory(ctx, k8sClient, DefaultMaintenanceHistoryNamespace)
+			return history[node.Name], err
+		}
+		Eventually(ctx, nodeHistory).Should(ConsistOf(SatisfyAll(
+			HaveField("ServerMaintenance", serverClaim.Name),
+			HaveField("Server", serverClaim.Spec.ServerRef.Name),
+			HaveField("ApprovedAt", Not(BeNil())),
+			HaveField("ApprovedBy", Not(BeEmpty())),
+			HaveField("StartedAt", Not(BeNil())),
+			HaveField("CompletedAt", BeNil()),
+		)))
+
+		By("Completing the maintenance")
+		Eventually(Update(node, func() {
+			delete(node.Labels, metalv1alpha1.ServerMaintenanceRequestedLabelKey)
+			delete(node.Labels, metalv1alpha1.ServerMaintenanceApprovedLabelKey)
+		})).Should(Succeed())
+		Eventually(ctx, nodeHistory).Should(ConsistOf(SatisfyAll(
+			HaveField("CompletedAt", Not(BeNil())),
+			HaveField("Outcome", MaintenanceOutcomeCompleted),
+		)))
+	})
+})
+
+var _ = Describe("NodeReconciler with a maintenance history that cannot be written", func() {
+	const historyNamespace = "maintenance-history"
+	ns, _, _ := SetupTest(CloudConfig{
+		ClusterName: "test-cluster",
+		MaintenanceHistory: MaintenanceHistory{
+			Enabled:   true,
+			Namespace: historyNamespace,
+		},
+	})
+
+	It("should report the maintenance on the Node and complete it once the history can be written", func(ctx SpecContext) {
+		_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+
+		By("Requesting maintenance")
+		Eventually(Update(node, func() {
+			metav1.SetMetaDataLabel(&node.ObjectMeta, metalv1alpha1.ServerMaintenanceRequestedLabelKey, TrueStr)
+		})).Should(Succeed())
+		maintenance := &metalv1alpha1.ServerMaintenance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      serverClaim.Name,
+				Namespace: serverClaim.Namespace,
+			},
+		}
+		Eventually(Get(maintenance)).Should(Succeed())
+
+		By("Ensuring the state of the ServerMaintenance is reported")
+		Eventually(Object(node)).Should(SatisfyAll(
+			HaveField("Annotations", HaveKeyWithValue(AnnotationServerMaintenanceState, string(metalv1alpha1.ServerMaintenanceStatePending))),
+			haveNodeCondition(NodeConditionServerMaintenance, corev1.ConditionTrue, string(metalv1alpha1.ServerMaintenanceStatePending)),
+		))
+
+		By("Creating the history namespace")
+		Expect(k8sClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: historyNamespace}})).To(Succeed())
+		nodeHistory := func(ctx SpecContext) ([]MaintenanceRecord, error) {
+			history, err := GetMaintenanceHistory(ctx, k8sClient, historyNamespace)
+			return history[node.Name], err
+		}
+
+		By("Ensuring the maintenance is recorded on the next reconciliation")
+		Eventually(Update(node, func() {
+			metav1.SetMetaDataLabel(&node.ObjectMeta, "example.com/touch", TrueStr)
+		})).Should(Succeed())
+		Eventually(ctx, nodeHistory).Should(ConsistOf(HaveField("CompletedAt", BeNil())))
+
+		By("Making the history ConfigMap immutable")
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: historyNamespace,
+				Name:      MaintenanceHistoryConfigMapName,
+			},
+		}
+		Eventually(Update(configMap, func() {
+			configMap.Immutable = ptr.To(true)
+		})).Should(Succeed())
+
+		By("Completing the maintenance")
+		Eventually(Update(node, func() {
+			delete(node.Labels, metalv1alpha1.ServerMaintenanceRequestedLabelKey)
+		})).Should(Succeed())
+		Eventually(Get(maintenance)).Should(MatchError(apierrors.IsNotFound, "IsNotFound"))
+
+		By("Ensuring the completion is held back until it is recorded")
+		Consistently(Object(node)).Should(haveNodeCondition(NodeConditionServerMaintenance, corev1.ConditionTrue, string(metalv1alpha1.ServerMaintenanceStatePending)))
+
+		By("Replacing the history ConfigMap with a mutable one")
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, configMap)).To(Succeed())
+		Expect(k8sClient.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: historyNamespace,
+				Name:      MaintenanceHistoryConfigMapName,
+			},
+			Data: configMap.Data,
+		})).To(Succeed())
+
+		By("Ensuring the completion is recorded and reported")
+		Eventually(Object(node)).WithTimeout(2*BaseReconcilerDelay + eventuallyTimeout).Should(
+			haveNodeCondition(NodeConditionServerMaintenance, corev1.ConditionFalse, conditionReasonCompleted))
+		Eventually(ctx, nodeHistory).Should(ConsistOf(HaveField("Outcome", MaintenanceOutcomeCancelled)))
+	})
+})
+
+var _ = Describe("NodeReconciler with BIOS updates", func() {
+	ns, _, _ := SetupTest(CloudConfig{
+		ClusterName: "test-cluster",
+		BIOSUpdates: BIOSUpdates{
+			Enabled: true,
+		},
+	})
+
+	It("should request BIOS settings and version updates and report their state", func(ctx SpecContext) {
+		server, _, node := createRegisteredNode(ctx, ns.Name)
+
+		By("Annotating the Node with BIOS settings and a BIOS version")
+		Eventually(Update(node, func() {
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationBIOSSettings, `{"BootMode":"UEFI"}`)
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationBIOSSettingsVersion, "1.0.0")
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationBIOSVersion, "2.0.0")
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationBIOSVersionImage, "http://images.example.com/bios-2.0.0.bin")
+		})).Should(Succeed())
+
+		settings := &metalv1alpha1.BIOSSettings{ObjectMeta: metav1.ObjectMeta{Name: server.Name}}
+		Eventually(Object(settings)).Should(SatisfyAll(
+			HaveField("Labels", HaveKeyWithValue(labelKeyManagedBy, cloudProviderMetalName)),
+			HaveField("Spec.ServerRef", Equal(&corev1.LocalObjectReference{Name: server.Name})),
+			HaveField("Spec.Version", "1.0.0"),
+			HaveField("Spec.SettingsFlow", ConsistOf(HaveField("Settings", HaveKeyWithValue("BootMode", "UEFI")))),
+			HaveField("Spec.ServerMaintenancePolicy", metalv1alpha1.ServerMaintenancePolicyOwnerApproval),
+		))
+		biosVersion := &metalv1alpha1.BIOSVersion{ObjectMeta: metav1.ObjectMeta{Name: server.Name}}
+		Eventually(Object(biosVersion)).Should(SatisfyAll(
+			HaveField("Spec.Version", "2.0.0"),
+			HaveField("Spec.Image.URI", "http://images.example.com/bios-2.0.0.bin"),
+			HaveField("Spec.ServerMaintenancePolicy", metalv1alpha1.ServerMaintenancePolicyOwnerApproval),
+		))
+		Eventually(Object(node)).Should(SatisfyAll(
+			haveNodeCondition(NodeConditionBIOSSettings, corev1.ConditionFalse, string(metalv1alpha1.BIOSSettingsStatePending)),
+			haveNodeCondition(NodeConditionBIOSVersion, corev1.ConditionFalse, string(metalv1alpha1.BIOSVersionStatePending)),
+		))
+
+		By("Completing the BIOS updates")
+		Eventually(UpdateStatus(settings, func() {
+			settings.Status.State = metalv1alpha1.BIOSSettingsStateApplied
+			settings.Status.ObservedGeneration = settings.Generation
+		})).Should(Succeed())
+		Eventually(UpdateStatus(biosVersion, func() {
+			biosVersion.Status.State = metalv1alpha1.BIOSVersionStateCompleted
+			biosVersion.Status.ObservedGeneration = biosVersion.Generation
+		})).Should(Succeed())
+		Eventually(Object(node)).Should(SatisfyAll(
+			haveNodeCondition(NodeConditionBIOSSettings, corev1.ConditionTrue, string(metalv1alpha1.BIOSSettingsStateApplied)),
+			haveNodeCondition(NodeConditionBIOSVersion, corev1.ConditionTrue, string(metalv1alpha1.BIOSVersionStateCompleted)),
+		))
+
+		By("Removing the BIOS annotations")
+		Eventually(Update(node, func() {
+			delete(node.Annotations, AnnotationBIOSSettings)
+			delete(node.Annotations, AnnotationBIOSVersion)
+		})).Should(Succeed())
+		Eventually(Get(settings)).Should(MatchError(apierrors.IsNotFound, "IsNotFound"))
+		Eventually(Get(biosVersion)).Should(MatchError(apierrors.IsNotFound, "IsNotFound"))
+		Eventually(Object(node)).Should(HaveField("Status.Conditions", Not(ContainElement(
+			HaveField("Type", BeElementOf(NodeConditionBIOSSettings, NodeConditionBIOSVersion))))))
+	})
+
+	It("should postpone deleting the BIOS object of a withdrawn update in progress", func(ctx SpecContext) {
+		server, _, node := createRegisteredNode(ctx, ns.Name)
+
+		By("Annotating the Node with a BIOS version")
+		Eventually(Update(node, func() {
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationBIOSVersion, "2.0.0")
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationBIOSVersionImage, "http://images.example.com/bios-2.0.0.bin")
+		})).Should(Succeed())
+		biosVersion := &metalv1alpha1.BIOSVersion{ObjectMeta: metav1.ObjectMeta{Name: server.Name}}
+		Eventually(Get(biosVersion)).Should(Succeed())
+		Eventually(UpdateStatus(biosVersion, func() {
+			biosVersion.Status.State = metalv1alpha1.BIOSVersionStateInProgress
+			biosVersion.Status.ObservedGeneration = biosVersion.Generation
+		})).Should(Succeed())
+		Eventually(Object(node)).Should(
+			haveNodeCondition(NodeConditionBIOSVersion, corev1.ConditionFalse, string(metalv1alpha1.BIOSVersionStateInProgress)))
+
+		By("Removing the BIOS annotations while the update is in progress")
+		Eventually(Update(node, func() {
+			delete(node.Annotations, AnnotationBIOSVersion)
+		})).Should(Succeed())
+		Consistently(Get(biosVersion)).Should(Succeed())
+
+		By("Completing the BIOS update")
+		Eventually(UpdateStatus(biosVersion, func() {
+			biosVersion.Status.State = metalv1alpha1.BIOSVersionStateCompleted
+		})).Should(Succeed())
+		Eventually(Get(biosVersion)).Should(MatchError(apierrors.IsNotFound, "IsNotFound"))
+		Eventually(Object(node)).Should(HaveField("Status.Conditions", Not(ContainElement(
+			HaveField("Type", NodeConditionBIOSVersion)))))
+	})
+
+	It("should report malformed BIOS settings", func(ctx SpecContext) {
+		_, _, node := createRegisteredNode(ctx, ns.Name)
+
+		Eventually(Update(node, func() {
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationBIOSSettings, "BootMode=UEFI")
+		})).Should(Succeed())
+		Eventually(Object(node)).Should(haveNodeCondition(NodeConditionBIOSSettings, corev1.ConditionFalse, conditionReasonInvalid))
+	})
+})
+
+var _ = Describe("NodeReconciler with reimage", func() {
+	ns, _, _ := SetupTest(CloudConfig{
+		ClusterName: "test-cluster",
+		Reimage: Reimage{
+			Enabled: true,
+		},
+	})
+
+	It("should drain the Node, update the image, power-cycle the server and wait for the Node to register again", func(ctx SpecContext) {
+		server, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+		Eventually(UpdateStatus(node, func() {
+			node.Status.NodeInfo.BootID = "boot-1"
+			node.Status.Conditions = append(node.Status.Conditions, corev1.NodeCondition{
+				Type:   corev1.NodeReady,
+				Status: corev1.ConditionTrue,
+			})
+		})).Should(Succeed())
+
+		By("Requesting a new image and ignition")
+		Eventually(Update(node, func() {
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationReimageImage, "example.com/os:2.0")
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationReimageIgnitionSecret, "ignition-2")
+		})).Should(Succeed())
+
+		Eventually(Object(serverClaim)).Should(SatisfyAll(
+			HaveField("Spec.Image", "example.com/os:2.0"),
+			HaveField("Spec.IgnitionSecretRef", Equal(&corev1.LocalObjectReference{Name: "ignition-2"})),
+			HaveField("Annotations", HaveKeyWithValue(AnnotationReimageBootID, "boot-1")),
+			HaveField("Annotations", HaveKey(AnnotationReimagePowerCycleTime)),
+		))
+		Eventually(Object(server)).Should(HaveField("Annotations",
+			HaveKeyWithValue(metalv1alpha1.OperationAnnotation, metalv1alpha1.PowerCycleServerPower)))
+		Eventually(Object(node)).Should(SatisfyAll(
+			HaveField("Spec.Unschedulable", BeTrue()),
+			haveNodeCondition(NodeConditionReimage, corev1.ConditionFalse, conditionReasonPowerCycling),
+		))
+
+		By("Registering the Node again with a new boot ID")
+		Eventually(UpdateStatus(node, func() {
+			node.Status.NodeInfo.BootID = "boot-2"
+		})).Should(Succeed())
+		Eventually(Object(node)).Should(SatisfyAll(
+			HaveField("Spec.Unschedulable", BeFalse()),
+			haveNodeCondition(NodeConditionReimage, corev1.ConditionTrue, conditionReasonCompleted),
+		))
+		Eventually(Object(serverClaim)).Should(HaveField("Annotations", SatisfyAll(
+			Not(HaveKey(AnnotationReimageBootID)),
+			Not(HaveKey(AnnotationReimagePowerCycleTime)),
+		)))
+	})
+})
+
+var _ = Describe("NodeReconciler with reimage and a registration timeout", func() {
+	ns, _, _ := SetupTest(CloudConfig{
+		ClusterName: "test-cluster",
+		Reimage: Reimage{
+			Enabled:             true,
+			RegistrationTimeout: metav1.Duration{Duration: 2 * time.Second},
+		},
+	})
+
+	It("should give up and uncordon the Node if it does not register again in time", func(ctx SpecContext) {
+		_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+		Eventually(UpdateStatus(node, func() {
+			node.Status.NodeInfo.BootID = "boot-1"
+			node.Status.Conditions = append(node.Status.Conditions, corev1.NodeCondition{
+				Type:   corev1.NodeReady,
+				Status: corev1.ConditionTrue,
+			})
+		})).Should(Succeed())
+
+		By("Requesting a new image")
+		Eventually(Update(node, func() {
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationReimageImage, "example.com/os:2.0")
+		})).Should(Succeed())
+		Eventually(Object(serverClaim)).Should(HaveField("Annotations", HaveKey(AnnotationReimagePowerCycleTime)))
+
+		By("Not registering the Node again")
+		Eventually(Object(node)).Should(SatisfyAll(
+			HaveField("Spec.Unschedulable", BeFalse()),
+			haveNodeCondition(NodeConditionReimage, corev1.ConditionFalse, conditionReasonRegistrationTimeout),
+		))
+		Eventually(Object(serverClaim)).Should(SatisfyAll(
+			HaveField("Spec.Image", "example.com/os:2.0"),
+			HaveField("Annotations", Not(HaveKey(AnnotationReimageBootID))),
+			HaveField("Annotations", Not(HaveKey(AnnotationReimagePowerCycleTime))),
+		))
+		Expect(reimageInProgress(node)).To(BeFalse())
+	})
+})
+
+var _ = Describe("NodeReconciler with reimage and a maintenance budget", func() {
+	ns, _, _ := SetupTest(CloudConfig{
+		ClusterName: "test-cluster",
+		Reimage: Reimage{
+			Enabled: true,
+		},
+		MaintenanceAutomation: MaintenanceAutomation{
+			Budget: MaintenanceBudget{MaxUnavailable: 1},
+		},
+	})
+
+	It("should hold back a reimage until the maintenance budget allows it", func(ctx SpecContext) {
+		_, _, first := createRegisteredNode(ctx, ns.Name)
+		_, secondServerClaim, second := createRegisteredNode(ctx, ns.Name)
+		for _, node := range []*corev1.Node{first, second} {
+			Eventually(UpdateStatus(node, func() {
+				node.Status.NodeInfo.BootID = "boot-1"
+				node.Status.Conditions = append(node.Status.Conditions, corev1.NodeCondition{
+					Type:   corev1.NodeReady,
+					Status: corev1.ConditionTrue,
+				})
+			})).Should(Succeed())
+		}
+
+		By("Reprovisioning the first Node")
+		Eventually(Update(first, func() {
+			metav1.SetMetaDataAnnotation(&first.ObjectMeta, AnnotationReimageImage, "example.com/os:2.0")
+		})).Should(Succeed())
+		Eventually(Object(first)).Should(haveNodeCondition(NodeConditionReimage, corev1.ConditionFalse, conditionReasonPowerCycling))
+
+		By("Requesting a reimage of the second Node")
+		Eventually(Update(second, func() {
+			metav1.SetMetaDataAnnotation(&second.ObjectMeta, AnnotationReimageImage, "example.com/os:2.0")
+		})).Should(Succeed())
+		Eventually(Object(second)).Should(haveNodeCondition(NodeConditionReimage, corev1.ConditionFalse, conditionReasonReimagePending))
+		Consistently(Object(secondServerClaim)).Should(HaveField("Spec.Image", Not(Equal("example.com/os:2.0"))))
+		Expect(second.Spec.Unschedulable).To(BeFalse())
+
+		By("Registering the first Node again")
+		Eventually(UpdateStatus(first, func() {
+			first.Status.NodeInfo.BootID = "boot-2"
+		})).Should(Succeed())
+		Eventually(Object(first)).Should(haveNodeCondition(NodeConditionReimage, corev1.ConditionTrue, conditionReasonCompleted))
+		Eventually(Object(secondServerClaim)).Should(HaveField("Spec.Image", "example.com/os:2.0"))
+	})
+})
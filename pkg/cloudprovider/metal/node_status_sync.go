@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// nodeStatusAnnotationKeys are the annotations of a ServerClaim that report the status of its Node.
+var nodeStatusAnnotationKeys = []string{
+	AnnotationNodeName,
+	AnnotationNodeReady,
+	AnnotationNodeKubeletVersion,
+	AnnotationNodeUnschedulable,
+	AnnotationNodeLastHeartbeat,
+}
+
+// reconcileNodeStatus reports the status of the Node in the annotations of its ServerClaim. An update
+// that only advances the heartbeat is written at most once per heartbeat interval, a withheld heartbeat
+// is written once the interval passed.
+func (r *ServerClaimBindingReconciler) reconcileNodeStatus(ctx context.Context, node *corev1.Node, serverClaim *metalv1alpha1.ServerClaim) (ctrl.Result, error) {
+	if !r.nodeStatusSync.Enabled {
+		return ctrl.Result{}, nil
+	}
+	desired := nodeStatusAnnotations(node)
+
+	var result ctrl.Result
+	heartbeat, ok := desired[AnnotationNodeLastHeartbeat]
+	current, currentOk := serverClaim.Annotations[AnnotationNodeLastHeartbeat]
+	if ok && currentOk && heartbeat != current && !nodeStatusChanged(serverClaim, desired) {
+		previous, err := time.Parse(time.RFC3339, current)
+		if remaining := time.Until(previous.Add(r.nodeStatusSync.GetHeartbeatInterval())); err == nil && remaining > 0 {
+			desired[AnnotationNodeLastHeartbeat] = current
+			result.RequeueAfter = remaining
+		}
+	}
+	return result, r.patchNodeStatusAnnotations(ctx, serverClaim, desired)
+}
+
+// clearNodeStatus removes the status of a Node that is gone from its ServerClaim.
+func (r *ServerClaimBindingReconciler) clearNodeStatus(ctx context.Context, serverClaim *metalv1alpha1.ServerClaim) error {
+	if !r.nodeStatusSync.Enabled || serverClaimOwnedByOtherCluster(serverClaim, r.clusterName) {
+		return nil
+	}
+	return r.patchNodeStatusAnnotations(ctx, serverClaim, nil)
+}
+
+// patchNodeStatusAnnotations sets the Node status annotations of the ServerClaim to the desired ones
+// and removes the others.
+func (r *ServerClaimBindingReconciler) patchNodeStatusAnnotations(ctx context.Context, serverClaim *metalv1alpha1.ServerClaim, desired map[string]string) error {
+	base := serverClaim.DeepCopy()
+	changed := false
+	for _, key := range nodeStatusAnnotationKeys {
+		value, ok := desired[key]
+		current, currentOk := serverClaim.Annotations[key]
+		switch {
+		case ok && (!currentOk || current != value):
+			if serverClaim.Annotations == nil {
+				serverClaim.Annotations = make(map[string]string)
+			}
+			serverClaim.Annotations[key] = value
+			changed = true
+		case !ok && currentOk:
+			delete(serverClaim.Annotations, key)
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	if err := r.metalClient.Patch(ctx, serverClaim, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("failed to patch node status of ServerClaim %s: %w", client.ObjectKeyFromObject(serverClaim), err)
+	}
+	klog.V(2).InfoS("Patched node status of ServerClaim", "ServerClaim", client.ObjectKeyFromObject(serverClaim), "Node", desired[AnnotationNodeName])
+	serverClaimPatchesTotal.WithLabelValues(serverClaimBindingControllerName, "node-status").Inc()
+	return nil
+}
+
+// nodeStatusChanged reports whether any Node status annotation other than the heartbeat differs from
+// the desired one.
+func nodeStatusChanged(serverClaim *metalv1alpha1.ServerClaim, desired map[string]string) bool {
+	for _, key := range nodeStatusAnnotationKeys {
+		if key == AnnotationNodeLastHeartbeat {
+			continue
+		}
+		value, ok := desired[key]
+		current, currentOk := serverClaim.Annotations[key]
+		if ok != currentOk || value != current {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeStatusAnnotations returns the annotations that describe the status of the Node. A Node that has
+// not reported a Ready condition yet is Unknown and has no heartbeat.
+func nodeStatusAnnotations(node *corev1.Node) map[string]string {
+	annotations := map[string]string{
+		AnnotationNodeName:          node.Name,
+		AnnotationNodeReady:         string(corev1.ConditionUnknown),
+		AnnotationNodeUnschedulable: strconv.FormatBool(node.Spec.Unschedulable),
+	}
+	if version := node.Status.NodeInfo.KubeletVersion; version != "" {
+		annotations[AnnotationNodeKubeletVersion] = version
+	}
+	if ready := getNodeCondition(node, corev1.NodeReady); ready != nil {
+		annotations[AnnotationNodeReady] = string(ready.Status)
+		if !ready.LastHeartbeatTime.IsZero() {
+			annotations[AnnotationNodeLastHeartbeat] = ready.LastHeartbeatTime.UTC().Format(time.RFC3339)
+		}
+	}
+	return annotations
+}
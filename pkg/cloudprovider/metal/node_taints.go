@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// hasNodeTaint reports whether the Node carries a taint with the given key.
+func hasNodeTaint(node *corev1.Node, key string) bool {
+	return slices.ContainsFunc(node.Spec.Taints, func(taint corev1.Taint) bool {
+		return taint.Key == key
+	})
+}
+
+// setNodeTaint adds a NoSchedule taint with the given key to the Node or removes it, and reports
+// whether the taints changed.
+func setNodeTaint(node *corev1.Node, key string, present bool) bool {
+	if hasNodeTaint(node, key) == present {
+		return false
+	}
+	if present {
+		node.Spec.Taints = append(node.Spec.Taints, corev1.Taint{
+			Key:    key,
+			Value:  TrueStr,
+			Effect: corev1.TaintEffectNoSchedule,
+		})
+		return true
+	}
+	node.Spec.Taints = slices.DeleteFunc(node.Spec.Taints, func(taint corev1.Taint) bool {
+		return taint.Key == key
+	})
+	return true
+}
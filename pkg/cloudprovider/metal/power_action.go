@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"context"
+	"fmt"
+
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PowerAction is a one-off power action requested through AnnotationPowerAction.
+type PowerAction string
+
+const (
+	// PowerActionReboot gracefully restarts the server.
+	PowerActionReboot PowerAction = "reboot"
+	// PowerActionPowerCycle powers the server off and on again.
+	PowerActionPowerCycle PowerAction = "power-cycle"
+	// PowerActionSoftOff gracefully powers the server off. It sets AnnotationPowerOff, so the
+	// server stays off until that annotation is removed.
+	PowerActionSoftOff PowerAction = "soft-off"
+)
+
+const (
+	powerActionResultRequested = "Requested"
+	powerActionResultRejected  = "Rejected"
+	powerActionResultFailed    = "Failed"
+
+	eventReasonPowerActionRequested = "PowerActionRequested"
+	eventReasonPowerActionRejected  = "PowerActionRejected"
+	eventReasonPowerActionFailed    = "PowerActionFailed"
+)
+
+// serverOperations maps the power actions that are executed by the metal-operator on the Server to
+// the value of its operation annotation.
+var serverOperations = map[PowerAction]string{
+	PowerActionReboot:     metalv1alpha1.GracefulRestartServerPower,
+	PowerActionPowerCycle: metalv1alpha1.PowerCycleServerPower,
+}
+
+// reconcilePowerAction runs the power action requested on the Node once per request ID and
+// acknowledges it with the handled ID and result annotations. The acknowledgement is saved with an
+// optimistic lock before the Server is touched, so that a failed patch or a stale Node never runs
+// the same request twice. Transient errors before the acknowledgement retry the request, permanent
+// ones reject it.
+func (r *NodeReconciler) reconcilePowerAction(ctx context.Context, node *corev1.Node, serverClaim *metalv1alpha1.ServerClaim) error {
+	action, ok := node.Annotations[AnnotationPowerAction]
+	if !ok {
+		return nil
+	}
+	requestID := node.Annotations[AnnotationPowerActionID]
+	if handledID, handled := node.Annotations[AnnotationPowerActionHandledID]; handled && handledID == requestID {
+		return nil
+	}
+
+	base := node.DeepCopy()
+	server, result, err := r.preparePowerAction(ctx, node, serverClaim, PowerAction(action), requestID)
+	if err != nil {
+		return err
+	}
+	node.Annotations[AnnotationPowerActionHandledID] = requestID
+	node.Annotations[AnnotationPowerActionResult] = result
+	if err := r.targetClient.Patch(ctx, node, client.MergeFromWithOptions(base, client.MergeFromWithOptimisticLock{})); err != nil {
+		return fmt.Errorf("failed to acknowledge power action %s of Node %s: %w", requestID, node.Name, err)
+	}
+	if result != powerActionResultRequested {
+		return nil
+	}
+	return r.executePowerAction(ctx, node, server, PowerAction(action), requestID)
+}
+
+// preparePowerAction validates the power action and returns the Server to run it on together with
+// the result to report on the Node. A soft-off only sets AnnotationPowerOff and needs no Server.
+func (r *NodeReconciler) preparePowerAction(ctx context.Context, node *corev1.Node, serverClaim *metalv1alpha1.ServerClaim, action PowerAction, requestID string) (*metalv1alpha1.Server, string, error) {
+	reject := func(format string, args ...any) (*metalv1alpha1.Server, string, error) {
+		message := fmt.Sprintf(format, args...)
+		klog.InfoS("Rejecting power action", "Node", node.Name, "Action", action, "RequestID", requestID, "Reason", message)
+		r.recorder.Eventf(node, nil, corev1.EventTypeWarning, eventReasonPowerActionRejected, eventActionPower,
+			"Rejected power action %s with request ID %q: %s", action, requestID, message)
+		powerActionsTotal.WithLabelValues(powerActionMetricLabel(action), powerActionResultRejected).Inc()
+		return nil, fmt.Sprintf("%s: %s", powerActionResultRejected, message), nil
+	}
+
+	if requestID == "" {
+		return reject("annotation %s is missing", AnnotationPowerActionID)
+	}
+
+	switch action {
+	case PowerActionSoftOff:
+		node.Annotations[AnnotationPowerOff] = TrueStr
+		return nil, powerActionResultRequested, nil
+	case PowerActionReboot, PowerActionPowerCycle:
+		if serverClaim.Spec.ServerRef == nil {
+			return reject("ServerClaim %s is not bound to a server", client.ObjectKeyFromObject(serverClaim))
+		}
+		server := &metalv1alpha1.Server{}
+		if err := r.metalClient.Get(ctx, client.ObjectKey{Name: serverClaim.Spec.ServerRef.Name}, server); err != nil {
+			if apierrors.IsNotFound(err) {
+				return reject("server %s does not exist", serverClaim.Spec.ServerRef.Name)
+			}
+			return nil, "", fmt.Errorf("failed to get server object for Node %s: %w", node.Name, err)
+		}
+		// A pending operation is waited for before the request is acknowledged.
+		if pending, ok := server.Annotations[metalv1alpha1.OperationAnnotation]; ok {
+			return nil, "", fmt.Errorf("server %s has pending operation %s", server.Name, pending)
+		}
+		return server, powerActionResultRequested, nil
+	default:
+		return reject("unknown power action, supported are %s, %s and %s", PowerActionReboot, PowerActionPowerCycle, PowerActionSoftOff)
+	}
+}
+
+// executePowerAction requests the acknowledged power action from the metal-operator. A failed
+// request is reported on the Node and not retried, as the request ID has already been handled.
+func (r *NodeReconciler) executePowerAction(ctx context.Context, node *corev1.Node, server *metalv1alpha1.Server, action PowerAction, requestID string) error {
+	if server != nil {
+		if err := r.requestServerOperation(ctx, server, serverOperations[action]); err != nil {
+			klog.ErrorS(err, "Failed to run power action", "Node", node.Name, "Action", action, "RequestID", requestID)
+			r.recorder.Eventf(node, server, corev1.EventTypeWarning, eventReasonPowerActionFailed, eventActionPower,
+				"Failed to run power action %s with request ID %q: %v", action, requestID, err)
+			powerActionsTotal.WithLabelValues(powerActionMetricLabel(action), powerActionResultFailed).Inc()
+
+			base := node.DeepCopy()
+			node.Annotations[AnnotationPowerActionResult] = fmt.Sprintf("%s: %v", powerActionResultFailed, err)
+			if err := r.targetClient.Patch(ctx, node, client.MergeFrom(base)); err != nil {
+				return fmt.Errorf("failed to report the result of power action %s of Node %s: %w", requestID, node.Name, err)
+			}
+			return nil
+		}
+	}
+
+	klog.InfoS("Requested power action", "Node", node.Name, "Action", action, "RequestID", requestID)
+	r.recorder.Eventf(node, nil, corev1.EventTypeNormal, eventReasonPowerActionRequested, eventActionPower,
+		"Requested power action %s with request ID %q", action, requestID)
+	powerActionsTotal.WithLabelValues(powerActionMetricLabel(action), powerActionResultRequested).Inc()
+	return nil
+}
+
+// powerActionMetricLabel bounds the action label of the power action metric to the known actions.
+func powerActionMetricLabel(action PowerAction) string {
+	if _, known := serverOperations[action]; !known && action != PowerActionSoftOff {
+		return "unknown"
+	}
+	return string(action)
+}
+
+// requestServerOperation requests an operation on the server from the metal-operator. It fails while
+// another operation is pending, so that the request is retried.
+func (r *NodeReconciler) requestServerOperation(ctx context.Context, server *metalv1alpha1.Server, operation string) error {
+	if pending, ok := server.Annotations[metalv1alpha1.OperationAnnotation]; ok {
+		return fmt.Errorf("server %s has pending operation %s", server.Name, pending)
+	}
+	serverBase := server.DeepCopy()
+	if server.Annotations == nil {
+		server.Annotations = make(map[string]string)
+	}
+	server.Annotations[metalv1alpha1.OperationAnnotation] = operation
+	if err := r.metalClient.Patch(ctx, server, client.MergeFrom(serverBase)); err != nil {
+		return fmt.Errorf("failed to request operation %s for server %s: %w", operation, server.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+)
+
+var _ = Describe("Power actions", func() {
+	ns, _, clusterName := SetupTest(CloudConfig{
+		ClusterName: "test-cluster",
+	})
+
+	var (
+		server      *metalv1alpha1.Server
+		serverClaim *metalv1alpha1.ServerClaim
+		node        *corev1.Node
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		server, serverClaim, node = createRegisteredNode(ctx, ns.Name)
+		Eventually(Object(serverClaim)).Should(HaveField("Labels", HaveKeyWithValue(LabelKeyClusterName, clusterName)))
+	})
+
+	requestPowerAction := func(action PowerAction, requestID string) {
+		Eventually(Update(node, func() {
+			if node.Annotations == nil {
+				node.Annotations = make(map[string]string)
+			}
+			node.Annotations[AnnotationPowerAction] = string(action)
+			node.Annotations[AnnotationPowerActionID] = requestID
+		})).Should(Succeed())
+	}
+
+	It("should reboot the server once per request", func(ctx SpecContext) {
+		By("Requesting a reboot")
+		requestPowerAction(PowerActionReboot, "1")
+
+		By("Ensuring the reboot is requested from the metal-operator and acknowledged")
+		Eventually(Object(server)).Should(HaveField("Annotations",
+			HaveKeyWithValue(metalv1alpha1.OperationAnnotation, metalv1alpha1.GracefulRestartServerPower)))
+		Eventually(Object(node)).Should(HaveField("Annotations", SatisfyAll(
+			HaveKeyWithValue(AnnotationPowerActionHandledID, "1"),
+			HaveKeyWithValue(AnnotationPowerActionResult, powerActionResultRequested),
+		)))
+
+		By("Completing the operation like the metal-operator")
+		Eventually(Update(server, func() {
+			delete(server.Annotations, metalv1alpha1.OperationAnnotation)
+		})).Should(Succeed())
+
+		By("Ensuring the same request is not run again")
+		Consistently(Object(server)).Should(HaveField("Annotations", Not(HaveKey(metalv1alpha1.OperationAnnotation))))
+
+		By("Requesting a power cycle with a new request ID")
+		requestPowerAction(PowerActionPowerCycle, "2")
+		Eventually(Object(server)).Should(HaveField("Annotations",
+			HaveKeyWithValue(metalv1alpha1.OperationAnnotation, metalv1alpha1.PowerCycleServerPower)))
+		Eventually(Object(node)).Should(HaveField("Annotations", HaveKeyWithValue(AnnotationPowerActionHandledID, "2")))
+	})
+
+	It("should not acknowledge a request while another server operation is pending", func(ctx SpecContext) {
+		By("Marking an operation as pending on the server")
+		Eventually(Update(server, func() {
+			if server.Annotations == nil {
+				server.Annotations = make(map[string]string)
+			}
+			server.Annotations[metalv1alpha1.OperationAnnotation] = metalv1alpha1.GracefulRestartServerPower
+		})).Should(Succeed())
+
+		By("Requesting a power cycle")
+		requestPowerAction(PowerActionPowerCycle, "1")
+		Consistently(Object(node)).Should(HaveField("Annotations", Not(HaveKey(AnnotationPowerActionHandledID))))
+
+		By("Completing the pending operation like the metal-operator")
+		Eventually(Update(server, func() {
+			delete(server.Annotations, metalv1alpha1.OperationAnnotation)
+		})).Should(Succeed())
+
+		By("Ensuring the power cycle is acknowledged and requested once the request is retried")
+		Eventually(Object(node)).WithTimeout(BaseReconcilerDelay + eventuallyTimeout).Should(HaveField("Annotations", HaveKeyWithValue(AnnotationPowerActionHandledID, "1")))
+		Eventually(Object(server)).Should(HaveField("Annotations",
+			HaveKeyWithValue(metalv1alpha1.OperationAnnotation, metalv1alpha1.PowerCycleServerPower)))
+	})
+
+	It("should power off the server softly", func(ctx SpecContext) {
+		requestPowerAction(PowerActionSoftOff, "1")
+
+		Eventually(Object(node)).Should(HaveField("Annotations", SatisfyAll(
+			HaveKeyWithValue(AnnotationPowerOff, TrueStr),
+			HaveKeyWithValue(AnnotationPowerActionHandledID, "1"),
+		)))
+		Eventually(Object(serverClaim)).Should(HaveField("Spec.Power", metalv1alpha1.PowerOff))
+	})
+
+	It("should reject unknown power actions and requests without an ID", func(ctx SpecContext) {
+		requestPowerAction("explode", "1")
+		Eventually(Object(node)).Should(HaveField("Annotations", SatisfyAll(
+			HaveKeyWithValue(AnnotationPowerActionHandledID, "1"),
+			HaveKeyWithValue(AnnotationPowerActionResult, HavePrefix(powerActionResultRejected)),
+		)))
+
+		requestPowerAction(PowerActionReboot, "")
+		Eventually(Object(node)).Should(HaveField("Annotations", SatisfyAll(
+			HaveKeyWithValue(AnnotationPowerActionHandledID, ""),
+			HaveKeyWithValue(AnnotationPowerActionResult, HavePrefix(powerActionResultRejected)),
+		)))
+		Consistently(Object(server)).Should(HaveField("Annotations", Not(HaveKey(metalv1alpha1.OperationAnnotation))))
+	})
+})
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	eventReasonPoweringOff = "PoweringOff"
+	eventReasonPoweringOn  = "PoweringOn"
+	eventReasonPowerFailed = "PowerFailed"
+
+	eventActionPower = "Power"
+
+	conditionReasonDraining     = "Draining"
+	conditionReasonDrained      = "Drained"
+	conditionReasonDrainTimeout = "DrainTimeout"
+)
+
+// reconcilePower applies the power action and power state requested on the Node to its ServerClaim.
+// ServerClaims owned by another cluster and servers that do not match the Node are left alone.
+func (r *NodeReconciler) reconcilePower(ctx context.Context, node *corev1.Node) (ctrl.Result, error) {
+	serverClaimKey, err := getObjectKeyFromProviderID(node.Spec.ProviderID)
+	if err != nil {
+		return ctrl.Result{}, nil
+	}
+	serverClaim := &metalv1alpha1.ServerClaim{}
+	if err := r.metalClient.Get(ctx, serverClaimKey, serverClaim); err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(2).InfoS("ServerClaim not found, skipping power control", "node", node.Name, "serverclaim", serverClaimKey)
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("unable to get ServerClaim: %w", err)
+	}
+	if serverClaimOwnedByOtherCluster(serverClaim, r.cloudConfig.ClusterName) {
+		klog.V(2).InfoS("ServerClaim is owned by another cluster, skipping power control", "node", node.Name, "serverclaim", serverClaimKey)
+		return ctrl.Result{}, nil
+	}
+	if hasServerMismatchTaint(node) {
+		klog.InfoS("Server does not match Node, skipping power control", "node", node.Name, "serverclaim", serverClaimKey)
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.reconcilePowerAction(ctx, node, serverClaim); err != nil {
+		return ctrl.Result{}, err
+	}
+	return r.setServerClaimPower(ctx, node, serverClaim)
+}
+
+// setServerClaimPower ensures that the server claim:
+// - is powered off if the node has the powerOffAnnotation and
+// - is powered on if the node does not have the powerOffAnnotation
+// If draining before power off is enabled, the Node is drained first and uncordoned again once
+// the powerOffAnnotation is removed.
+// This does not guarantee that other controllers such as the
+// machine-controller-manager interfere with the power state of the server claim.
+func (r *NodeReconciler) setServerClaimPower(ctx context.Context, node *corev1.Node, serverClaim *metalv1alpha1.ServerClaim) (ctrl.Result, error) {
+	_, powerOff := node.Annotations[AnnotationPowerOff]
+	switch {
+	case powerOff && serverClaim.Spec.Power != metalv1alpha1.PowerOff:
+		if r.cloudConfig.PowerOff.Drain {
+			drained, requeueAfter, err := r.drainBeforePowerOff(ctx, node, serverClaim)
+			if err != nil || !drained {
+				return ctrl.Result{RequeueAfter: requeueAfter}, err
+			}
+		}
+		klog.InfoS("Ensuring server is powered off", "Node", node.Name)
+		return ctrl.Result{}, r.patchServerClaimPower(ctx, node, serverClaim, metalv1alpha1.PowerOff, eventReasonPoweringOff)
+	case !powerOff:
+		if err := r.drainer.release(ctx, node, NodeConditionPowerOffDrained); err != nil {
+			return ctrl.Result{}, err
+		}
+		if serverClaim.Spec.Power == metalv1alpha1.PowerOff {
+			klog.InfoS("Ensuring server is powered on", "Node", node.Name)
+			return ctrl.Result{}, r.patchServerClaimPower(ctx, node, serverClaim, metalv1alpha1.PowerOn, eventReasonPoweringOn)
+		}
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *NodeReconciler) patchServerClaimPower(ctx context.Context, node *corev1.Node, serverClaim *metalv1alpha1.ServerClaim, power metalv1alpha1.Power, reason string) error {
+	serverClaimBase := serverClaim.DeepCopy()
+	serverClaim.Spec.Power = power
+	if err := r.metalClient.Patch(ctx, serverClaim, client.MergeFrom(serverClaimBase)); err != nil {
+		r.recorder.Eventf(node, nil, corev1.EventTypeWarning, eventReasonPowerFailed, eventActionPower,
+			"Failed to set power of ServerClaim %s to %s: %v", client.ObjectKeyFromObject(serverClaim), power, err)
+		return fmt.Errorf("failed to patch server claim for Node %s: %w", node.Name, err)
+	}
+	serverClaimPatchesTotal.WithLabelValues(nodeControllerName, "power").Inc()
+	r.recorder.Eventf(node, nil, corev1.EventTypeNormal, reason, eventActionPower,
+		"Set power of ServerClaim %s to %s", client.ObjectKeyFromObject(serverClaim), power)
+	return nil
+}
+
+// drainBeforePowerOff drains a Node whose server is about to be powered off and reports the progress
+// in the PowerOffDrained condition. Once the Node is drained or the drain timed out, the server keeps
+// running for the grace period. It reports whether the server may be powered off now. Otherwise the
+// drain has to be checked again after the returned delay.
+func (r *NodeReconciler) drainBeforePowerOff(ctx context.Context, node *corev1.Node, serverClaim *metalv1alpha1.ServerClaim) (bool, time.Duration, error) {
+	powerOff := r.cloudConfig.PowerOff
+	condition := getNodeCondition(node, NodeConditionPowerOffDrained)
+	if condition != nil && condition.Status == corev1.ConditionTrue {
+		if remaining := time.Until(condition.LastTransitionTime.Add(powerOff.GetGracePeriod())); remaining > 0 {
+			return false, remaining, nil
+		}
+		return true, 0, nil
+	}
+	if condition == nil {
+		klog.InfoS("Draining Node before powering off server", "Node", node.Name, "ServerClaim", client.ObjectKeyFromObject(serverClaim))
+		r.recorder.Eventf(node, nil, corev1.EventTypeNormal, eventReasonDrainingNode, eventActionDrain,
+			"Draining Node before powering off ServerClaim %s", client.ObjectKeyFromObject(serverClaim))
+		if _, err := patchNodeConditions(ctx, r.targetClient, node, corev1.NodeCondition{
+			Type:    NodeConditionPowerOffDrained,
+			Status:  corev1.ConditionFalse,
+			Reason:  conditionReasonDraining,
+			Message: "Evicting pods before the server is powered off",
+		}); err != nil {
+			return false, 0, err
+		}
+		condition = getNodeCondition(node, NodeConditionPowerOffDrained)
+	}
+	started := condition.LastTransitionTime.Time
+
+	drained, err := r.drainer.drain(ctx, node)
+	if err != nil {
+		return false, 0, err
+	}
+	gracePeriod := powerOff.GetGracePeriod()
+	if drained {
+		r.recorder.Eventf(node, nil, corev1.EventTypeNormal, eventReasonNodeDrained, eventActionDrain,
+			"Node drained, powering off ServerClaim %s in %s", client.ObjectKeyFromObject(serverClaim), gracePeriod)
+		_, err := patchNodeConditions(ctx, r.targetClient, node, corev1.NodeCondition{
+			Type:    NodeConditionPowerOffDrained,
+			Status:  corev1.ConditionTrue,
+			Reason:  conditionReasonDrained,
+			Message: "All pods were evicted before the server was powered off",
+		})
+		return false, gracePeriod, err
+	}
+
+	drainTimeout := powerOff.GetDrainTimeout()
+	if remaining := time.Until(started.Add(drainTimeout)); remaining > 0 {
+		return false, min(DrainRequeueDelay, remaining), nil
+	}
+	klog.InfoS("Timed out draining Node, powering off server", "Node", node.Name, "ServerClaim", client.ObjectKeyFromObject(serverClaim), "DrainTimeout", drainTimeout)
+	r.recorder.Eventf(node, nil, corev1.EventTypeWarning, eventReasonDrainTimeout, eventActionDrain,
+		"Node was not drained within %s, powering off ServerClaim %s in %s", drainTimeout, client.ObjectKeyFromObject(serverClaim), gracePeriod)
+	_, err = patchNodeConditions(ctx, r.targetClient, node, corev1.NodeCondition{
+		Type:    NodeConditionPowerOffDrained,
+		Status:  corev1.ConditionTrue,
+		Reason:  conditionReasonDrainTimeout,
+		Message: "The server was powered off before all pods were evicted",
+	})
+	return false, gracePeriod, err
+}
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const propagationPrefixSuffix = "*"
+
+var (
+	// unpropagatedLabels and unpropagatedAnnotations are managed on the Node by the provider or trigger
+	// actions on the hardware of the Node, so they are never copied from the ServerClaim.
+	unpropagatedLabels = append([]string{
+		metalv1alpha1.ServerMaintenanceNeededLabelKey,
+		LabelKeyClusterName,
+		LabelKeyTopologySwitch,
+		LabelKeyTopologyRack,
+		LabelKeyTopologyPod,
+		corev1.LabelTopologyZone,
+		corev1.LabelTopologyRegion,
+		corev1.LabelInstanceTypeStable,
+	}, restrictedNodeLabels...)
+	unpropagatedAnnotations = append([]string{
+		AnnotationPropagatedLabels,
+		AnnotationPropagatedAnnotations,
+		AnnotationDrainCordoned,
+		AnnotationMaintenanceRequestedAt,
+		AnnotationMaintenanceApprovedAt,
+		AnnotationMaintenanceApprovalPriority,
+		AnnotationMaintenancePriority,
+		AnnotationMaintenanceReason,
+		AnnotationNextMaintenanceWindow,
+		AnnotationServerMaintenanceState,
+		AnnotationServerMaintenanceStartTime,
+		AnnotationServerMaintenanceReason,
+		AnnotationPowerActionHandledID,
+		AnnotationPowerActionResult,
+	}, restrictedNodeAnnotations...)
+)
+
+// validatePropagation reports the first entry of the Propagation that is neither a key nor a prefix.
+func validatePropagation(propagation Propagation) error {
+	for i, entry := range propagation.Labels {
+		if err := validatePropagationEntry(entry); err != nil {
+			return fmt.Errorf("labels[%d]: %w", i, err)
+		}
+	}
+	for i, entry := range propagation.Annotations {
+		if err := validatePropagationEntry(entry); err != nil {
+			return fmt.Errorf("annotations[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func validatePropagationEntry(entry string) error {
+	key, prefix := strings.CutSuffix(entry, propagationPrefixSuffix)
+	if key == "" {
+		return fmt.Errorf("%q does not select a key or prefix", entry)
+	}
+	if prefix {
+		// A prefix may end anywhere in a key, so it only has to be valid once a name follows.
+		key += "x"
+	}
+	if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+		return fmt.Errorf("%q is invalid: %s", entry, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// propagateMetadata copies the configured labels and annotations of the ServerClaim to the Node. The
+// copied keys are recorded on the Node, so that keys which are no longer copied are removed while keys
+// that were set on the Node by others are kept.
+func propagateMetadata(serverClaim *metalv1alpha1.ServerClaim, node *corev1.Node, propagation Propagation) {
+	if node.Labels == nil {
+		node.Labels = make(map[string]string)
+	}
+	if node.Annotations == nil {
+		node.Annotations = make(map[string]string)
+	}
+	propagateKeys(serverClaim.Labels, node.Labels, node.Annotations, AnnotationPropagatedLabels, propagation.Labels, unpropagatedLabels)
+	propagateKeys(serverClaim.Annotations, node.Annotations, node.Annotations, AnnotationPropagatedAnnotations, propagation.Annotations, unpropagatedAnnotations)
+}
+
+// propagateKeys copies the selected keys from source to target and records them in the tracking
+// annotation. Keys recorded before that are not selected anymore are removed from target.
+func propagateKeys(source, target, annotations map[string]string, trackingKey string, selectors, excluded []string) {
+	var propagated []string
+	for key, value := range source {
+		if !propagationSelects(selectors, key) || slices.Contains(excluded, key) {
+			continue
+		}
+		target[key] = value
+		propagated = append(propagated, key)
+	}
+	if previous := annotations[trackingKey]; previous != "" {
+		for key := range strings.SplitSeq(previous, ",") {
+			if !slices.Contains(propagated, key) && !slices.Contains(excluded, key) {
+				delete(target, key)
+			}
+		}
+	}
+
+	if len(propagated) == 0 {
+		delete(annotations, trackingKey)
+		return
+	}
+	slices.Sort(propagated)
+	annotations[trackingKey] = strings.Join(propagated, ",")
+}
+
+// propagationSelects reports whether one of the selectors matches the key.
+func propagationSelects(selectors []string, key string) bool {
+	for _, selector := range selectors {
+		if prefix, ok := strings.CutSuffix(selector, propagationPrefixSuffix); ok {
+			if strings.HasPrefix(key, prefix) {
+				return true
+			}
+			continue
+		}
+		if key == selector {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("validatePropagation", func() {
+	DescribeTable("should validate the keys and prefixes",
+		func(propagation Propagation, valid bool) {
+			err := validatePropagation(propagation)
+			if valid {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("key", Propagation{Labels: []string{"example.com/reservation-owner"}}, true),
+		Entry("prefix of a key prefix", Propagation{Annotations: []string{"example.com/*"}}, true),
+		Entry("prefix of a name", Propagation{Labels: []string{"example.com/hw-*"}}, true),
+		Entry("everything", Propagation{Labels: []string{"*"}}, false),
+		Entry("empty key", Propagation{Annotations: []string{""}}, false),
+		Entry("invalid key", Propagation{Labels: []string{"example.com/a b"}}, false),
+	)
+})
+
+var _ = Describe("propagateMetadata", func() {
+	It("should not copy the keys managed by the provider", func() {
+		serverClaim := &metalv1alpha1.ServerClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					LabelKeyClusterName:      "other-cluster",
+					corev1.LabelTopologyZone: "zone-a",
+					"kubernetes.io/team":     "team-a",
+				},
+				Annotations: map[string]string{
+					AnnotationMaintenanceApprovedAt:  "2026-01-01T00:00:00Z",
+					AnnotationMaintenanceRequestedAt: "2026-01-01T00:00:00Z",
+					AnnotationDrainCordoned:          TrueStr,
+					AnnotationReimageImage:           "example.com/os:2.0",
+					"metal.ironcore.dev/team":        "team-a",
+				},
+			},
+		}
+		node := &corev1.Node{}
+		propagateMetadata(serverClaim, node, Propagation{
+			Labels:      []string{"kubernetes.io/*", "topology.kubernetes.io/*"},
+			Annotations: []string{"metal.ironcore.dev/*"},
+		})
+		Expect(node.Labels).To(Equal(map[string]string{"kubernetes.io/team": "team-a"}))
+		Expect(node.Annotations).To(Equal(map[string]string{
+			"metal.ironcore.dev/team":       "team-a",
+			AnnotationPropagatedLabels:      "kubernetes.io/team",
+			AnnotationPropagatedAnnotations: "metal.ironcore.dev/team",
+		}))
+	})
+})
@@ -0,0 +1,350 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	eventReasonReimageStarted      = "ReimageStarted"
+	eventReasonImageUpdated        = "ImageUpdated"
+	eventReasonPowerCycling        = "PowerCycling"
+	eventReasonReimageCompleted    = "ReimageCompleted"
+	eventReasonRegistrationTimeout = "RegistrationTimeout"
+
+	eventActionReimage = "Reimage"
+
+	conditionReasonReimagePending         = "Pending"
+	conditionReasonImageUpdated           = "ImageUpdated"
+	conditionReasonPowerCycling           = "PowerCycling"
+	conditionReasonWaitingForRegistration = "WaitingForRegistration"
+	conditionReasonRegistrationTimeout    = "RegistrationTimeout"
+)
+
+// reimageInProgress reports whether the server of the Node is being reprovisioned. A reimage that
+// waits to be admitted or whose Node did not register again in time is not in progress.
+func reimageInProgress(node *corev1.Node) bool {
+	condition := getNodeCondition(node, NodeConditionReimage)
+	return condition != nil && condition.Status == corev1.ConditionFalse &&
+		condition.Reason != conditionReasonReimagePending && condition.Reason != conditionReasonRegistrationTimeout
+}
+
+// reimagePending reports whether the reimage of the Node waits for its maintenance window or the
+// maintenance budget.
+func reimagePending(node *corev1.Node) bool {
+	condition := getNodeCondition(node, NodeConditionReimage)
+	return condition != nil && condition.Reason == conditionReasonReimagePending
+}
+
+// reconcileReimage reprovisions the server of the Node with the image requested in its annotations.
+// The Node is drained, the image and ignition of its ServerClaim are updated and the server is
+// power-cycled. The progress is tracked on the ServerClaim, so that it survives the Node registering
+// again, and reported in the Reimage condition of the Node. A reimage only starts within a maintenance
+// window of the Node and the maintenance budget.
+func (r *NodeReconciler) reconcileReimage(ctx context.Context, node *corev1.Node) (ctrl.Result, error) {
+	if !r.cloudConfig.Reimage.Enabled {
+		return ctrl.Result{}, nil
+	}
+	serverClaimKey, err := getObjectKeyFromProviderID(node.Spec.ProviderID)
+	if err != nil {
+		return ctrl.Result{}, nil
+	}
+	serverClaim := &metalv1alpha1.ServerClaim{}
+	if err := r.metalClient.Get(ctx, serverClaimKey, serverClaim); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("unable to get ServerClaim: %w", err)
+	}
+	if serverClaimOwnedByOtherCluster(serverClaim, r.cloudConfig.ClusterName) || serverClaim.Spec.ServerRef == nil ||
+		hasServerMismatchTaint(node) {
+		return ctrl.Result{}, nil
+	}
+
+	if _, ok := serverClaim.Annotations[AnnotationReimageBootID]; ok {
+		return r.awaitReimageRegistration(ctx, node, serverClaim)
+	}
+
+	image := node.Annotations[AnnotationReimageImage]
+	ignitionSecret := node.Annotations[AnnotationReimageIgnitionSecret]
+	requested := image != "" && (serverClaim.Spec.Image != image ||
+		(ignitionSecret != "" && (serverClaim.Spec.IgnitionSecretRef == nil || serverClaim.Spec.IgnitionSecretRef.Name != ignitionSecret)))
+	if !requested {
+		if reimageInProgress(node) {
+			klog.InfoS("Reimage was withdrawn before the ServerClaim was updated", "node", node.Name, "serverclaim", serverClaimKey)
+			return ctrl.Result{}, r.drainer.release(ctx, node, NodeConditionReimage)
+		}
+		if image == "" || reimagePending(node) {
+			return ctrl.Result{}, removeNodeCondition(ctx, r.targetClient, node, NodeConditionReimage)
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if !reimageInProgress(node) {
+		admitted, requeueAfter, err := r.admitReimage(ctx, node, image)
+		if err != nil || !admitted {
+			return ctrl.Result{RequeueAfter: requeueAfter}, err
+		}
+	}
+	drained, requeueAfter, err := r.drainBeforeReimage(ctx, node, image)
+	if err != nil || !drained {
+		return ctrl.Result{RequeueAfter: requeueAfter}, err
+	}
+	if err := r.updateServerClaimImage(ctx, node, serverClaim, image, ignitionSecret); err != nil {
+		return ctrl.Result{}, err
+	}
+	return r.awaitReimageRegistration(ctx, node, serverClaim)
+}
+
+// admitReimage reports whether the reimage of the Node may start. Otherwise the Node is marked as
+// waiting for its maintenance window or the maintenance budget, and has to be checked again after
+// the returned delay.
+func (r *NodeReconciler) admitReimage(ctx context.Context, node *corev1.Node, image string) (bool, time.Duration, error) {
+	message := fmt.Sprintf("Waiting for the maintenance window to reprovision the server with image %s", image)
+	open, requeueAfter := r.maintenanceWindowOpen(node)
+	if open {
+		admitted, err := r.admitMaintenance(ctx, node)
+		if err != nil || admitted {
+			return admitted, 0, err
+		}
+		message = fmt.Sprintf("Waiting for the maintenance budget to reprovision the server with image %s", image)
+		requeueAfter = MaintenanceBudgetRequeueDelay
+	}
+	klog.V(2).InfoS("Holding back reimage", "node", node.Name, "image", image, "open", open)
+	if _, err := patchNodeConditions(ctx, r.targetClient, node, corev1.NodeCondition{
+		Type:    NodeConditionReimage,
+		Status:  corev1.ConditionFalse,
+		Reason:  conditionReasonReimagePending,
+		Message: message,
+	}); err != nil {
+		return false, 0, err
+	}
+	return false, requeueAfter, nil
+}
+
+// drainBeforeReimage drains a Node whose server is about to be reprovisioned. It reports whether the
+// ServerClaim may be updated now. Otherwise the drain has to be checked again after the returned delay.
+func (r *NodeReconciler) drainBeforeReimage(ctx context.Context, node *corev1.Node, image string) (bool, time.Duration, error) {
+	condition := getNodeCondition(node, NodeConditionReimage)
+	if condition == nil || condition.Reason != conditionReasonDraining {
+		klog.InfoS("Draining Node before reprovisioning its server", "node", node.Name, "image", image)
+		r.recorder.Eventf(node, nil, corev1.EventTypeNormal, eventReasonReimageStarted, eventActionReimage,
+			"Draining Node before reprovisioning it with image %s", image)
+		if _, err := patchNodeConditions(ctx, r.targetClient, node, corev1.NodeCondition{
+			Type:    NodeConditionReimage,
+			Status:  corev1.ConditionFalse,
+			Reason:  conditionReasonDraining,
+			Message: fmt.Sprintf("Evicting pods before the server is reprovisioned with image %s", image),
+		}); err != nil {
+			return false, 0, err
+		}
+		condition = getNodeCondition(node, NodeConditionReimage)
+	}
+	// The heartbeat of the condition is only bumped when it changes, i.e. when the drain started.
+	started := condition.LastHeartbeatTime.Time
+
+	drained, err := r.drainer.drain(ctx, node)
+	if err != nil {
+		return false, 0, err
+	}
+	if drained {
+		r.recorder.Eventf(node, nil, corev1.EventTypeNormal, eventReasonNodeDrained, eventActionDrain,
+			"Node drained, reprovisioning it with image %s", image)
+		return true, 0, nil
+	}
+
+	gracePeriod := r.cloudConfig.Reimage.GetDrainGracePeriod()
+	if remaining := time.Until(started.Add(gracePeriod)); remaining > 0 {
+		return false, min(DrainRequeueDelay, remaining), nil
+	}
+	klog.InfoS("Timed out draining Node, reprovisioning server", "node", node.Name, "gracePeriod", gracePeriod)
+	r.recorder.Eventf(node, nil, corev1.EventTypeWarning, eventReasonDrainTimeout, eventActionDrain,
+		"Node was not drained within %s, reprovisioning it with image %s", gracePeriod, image)
+	return true, 0, nil
+}
+
+// updateServerClaimImage sets the requested image and ignition on the ServerClaim and records the
+// boot ID of the Node to recognize when it registered again.
+func (r *NodeReconciler) updateServerClaimImage(ctx context.Context, node *corev1.Node, serverClaim *metalv1alpha1.ServerClaim, image, ignitionSecret string) error {
+	serverClaimKey := client.ObjectKeyFromObject(serverClaim)
+	base := serverClaim.DeepCopy()
+	serverClaim.Spec.Image = image
+	if ignitionSecret != "" {
+		serverClaim.Spec.IgnitionSecretRef = &corev1.LocalObjectReference{Name: ignitionSecret}
+	}
+	metav1.SetMetaDataAnnotation(&serverClaim.ObjectMeta, AnnotationReimageBootID, node.Status.NodeInfo.BootID)
+	if err := r.metalClient.Patch(ctx, serverClaim, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("failed to update image of ServerClaim %s: %w", serverClaimKey, err)
+	}
+	serverClaimPatchesTotal.WithLabelValues(nodeControllerName, "image").Inc()
+
+	klog.InfoS("Updated image of ServerClaim", "node", node.Name, "serverclaim", serverClaimKey, "image", image)
+	r.recorder.Eventf(node, nil, corev1.EventTypeNormal, eventReasonImageUpdated, eventActionReimage,
+		"Set image of ServerClaim %s to %s", serverClaimKey, image)
+	_, err := patchNodeConditions(ctx, r.targetClient, node, corev1.NodeCondition{
+		Type:    NodeConditionReimage,
+		Status:  corev1.ConditionFalse,
+		Reason:  conditionReasonImageUpdated,
+		Message: fmt.Sprintf("Set image of ServerClaim %s to %s", serverClaimKey, image),
+	})
+	return err
+}
+
+// awaitReimageRegistration power-cycles the server of a ServerClaim whose image was updated and waits
+// until its Node is ready with a new boot ID.
+func (r *NodeReconciler) awaitReimageRegistration(ctx context.Context, node *corev1.Node, serverClaim *metalv1alpha1.ServerClaim) (ctrl.Result, error) {
+	powerCycleTime, powerCycled := serverClaim.Annotations[AnnotationReimagePowerCycleTime]
+	if !powerCycled {
+		if err := r.powerCycleForReimage(ctx, node, serverClaim); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: r.cloudConfig.Reimage.GetRegistrationTimeout()}, nil
+	}
+
+	bootID := node.Status.NodeInfo.BootID
+	ready := getNodeCondition(node, corev1.NodeReady)
+	registered := ready != nil && ready.Status == corev1.ConditionTrue
+	if registered && bootID != "" && bootID != serverClaim.Annotations[AnnotationReimageBootID] {
+		return ctrl.Result{}, r.completeReimage(ctx, node, serverClaim)
+	}
+
+	started, err := time.Parse(time.RFC3339, powerCycleTime)
+	if err != nil {
+		klog.ErrorS(err, "Invalid power cycle time of reprovisioned ServerClaim", "serverclaim", client.ObjectKeyFromObject(serverClaim))
+		started = time.Now()
+	}
+	timeout := r.cloudConfig.Reimage.GetRegistrationTimeout()
+	if remaining := time.Until(started.Add(timeout)); remaining > 0 {
+		condition := corev1.NodeCondition{
+			Type:    NodeConditionReimage,
+			Status:  corev1.ConditionFalse,
+			Reason:  conditionReasonPowerCycling,
+			Message: fmt.Sprintf("Power-cycled server %s to boot image %s", serverClaim.Spec.ServerRef.Name, serverClaim.Spec.Image),
+		}
+		if !registered || bootID != serverClaim.Annotations[AnnotationReimageBootID] {
+			condition.Reason = conditionReasonWaitingForRegistration
+			condition.Message = fmt.Sprintf("Waiting for the Node to register again with image %s", serverClaim.Spec.Image)
+		}
+		if _, err := patchNodeConditions(ctx, r.targetClient, node, condition); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: remaining}, nil
+	}
+
+	return ctrl.Result{}, r.failReimage(ctx, node, serverClaim, timeout)
+}
+
+// failReimage ends the reimage of a Node that did not register again in time. The Node is released
+// from the drain, so that it no longer holds the maintenance budget or other drains, and the progress
+// is cleared from the ServerClaim.
+func (r *NodeReconciler) failReimage(ctx context.Context, node *corev1.Node, serverClaim *metalv1alpha1.ServerClaim, timeout time.Duration) error {
+	changed, err := patchNodeConditions(ctx, r.targetClient, node, corev1.NodeCondition{
+		Type:    NodeConditionReimage,
+		Status:  corev1.ConditionFalse,
+		Reason:  conditionReasonRegistrationTimeout,
+		Message: fmt.Sprintf("The Node did not register again within %s after the server was power-cycled", timeout),
+	})
+	if err != nil {
+		return err
+	}
+	if changed {
+		klog.InfoS("Reprovisioned Node did not register again", "node", node.Name, "timeout", timeout)
+		r.recorder.Eventf(node, nil, corev1.EventTypeWarning, eventReasonRegistrationTimeout, eventActionReimage,
+			"Node did not register again within %s after its server was power-cycled", timeout)
+	}
+	if !drainHeld(node, NodeConditionReimage) {
+		if err := r.drainer.uncordon(ctx, node); err != nil {
+			return err
+		}
+	}
+	if err := r.clearReimageProgress(ctx, serverClaim); err != nil {
+		return err
+	}
+	r.enqueueWaitingForMaintenance(ctx)
+	return nil
+}
+
+// powerCycleForReimage power-cycles the server of the ServerClaim to boot the updated image.
+func (r *NodeReconciler) powerCycleForReimage(ctx context.Context, node *corev1.Node, serverClaim *metalv1alpha1.ServerClaim) error {
+	server := &metalv1alpha1.Server{}
+	if err := r.metalClient.Get(ctx, client.ObjectKey{Name: serverClaim.Spec.ServerRef.Name}, server); err != nil {
+		return fmt.Errorf("failed to get server object for Node %s: %w", node.Name, err)
+	}
+
+	// The power cycle is recorded before it is requested, so that it is never requested twice. The
+	// record is withdrawn if the request fails, so that it is retried.
+	serverClaimKey := client.ObjectKeyFromObject(serverClaim)
+	base := serverClaim.DeepCopy()
+	metav1.SetMetaDataAnnotation(&serverClaim.ObjectMeta, AnnotationReimagePowerCycleTime, time.Now().UTC().Format(time.RFC3339))
+	if err := r.metalClient.Patch(ctx, serverClaim, client.MergeFromWithOptions(base, client.MergeFromWithOptimisticLock{})); err != nil {
+		return fmt.Errorf("failed to record power cycle of ServerClaim %s: %w", serverClaimKey, err)
+	}
+	if err := r.requestServerOperation(ctx, server, metalv1alpha1.PowerCycleServerPower); err != nil {
+		recorded := serverClaim.DeepCopy()
+		delete(serverClaim.Annotations, AnnotationReimagePowerCycleTime)
+		if withdrawErr := r.metalClient.Patch(ctx, serverClaim, client.MergeFrom(recorded)); withdrawErr != nil {
+			klog.ErrorS(withdrawErr, "Failed to withdraw power cycle of ServerClaim", "serverclaim", serverClaimKey)
+		}
+		return err
+	}
+
+	klog.InfoS("Power-cycling server to reprovision Node", "node", node.Name, "server", server.Name)
+	r.recorder.Eventf(node, nil, corev1.EventTypeNormal, eventReasonPowerCycling, eventActionReimage,
+		"Power-cycling server %s to boot image %s", server.Name, serverClaim.Spec.Image)
+	_, err := patchNodeConditions(ctx, r.targetClient, node, corev1.NodeCondition{
+		Type:    NodeConditionReimage,
+		Status:  corev1.ConditionFalse,
+		Reason:  conditionReasonPowerCycling,
+		Message: fmt.Sprintf("Power-cycled server %s to boot image %s", server.Name, serverClaim.Spec.Image),
+	})
+	return err
+}
+
+// completeReimage clears the progress from the ServerClaim and uncordons the Node that registered again.
+func (r *NodeReconciler) completeReimage(ctx context.Context, node *corev1.Node, serverClaim *metalv1alpha1.ServerClaim) error {
+	if err := r.clearReimageProgress(ctx, serverClaim); err != nil {
+		return err
+	}
+
+	if !drainHeld(node, NodeConditionReimage) {
+		if err := r.drainer.uncordon(ctx, node); err != nil {
+			return err
+		}
+	}
+	if _, err := patchNodeConditions(ctx, r.targetClient, node, corev1.NodeCondition{
+		Type:    NodeConditionReimage,
+		Status:  corev1.ConditionTrue,
+		Reason:  conditionReasonCompleted,
+		Message: fmt.Sprintf("The Node registered again with image %s", serverClaim.Spec.Image),
+	}); err != nil {
+		return err
+	}
+	klog.InfoS("Node registered again after reprovisioning", "node", node.Name, "image", serverClaim.Spec.Image)
+	r.recorder.Eventf(node, nil, corev1.EventTypeNormal, eventReasonReimageCompleted, eventActionReimage,
+		"Node registered again with image %s", serverClaim.Spec.Image)
+	r.enqueueWaitingForMaintenance(ctx)
+	return nil
+}
+
+// clearReimageProgress removes the progress of a finished reimage from the ServerClaim.
+func (r *NodeReconciler) clearReimageProgress(ctx context.Context, serverClaim *metalv1alpha1.ServerClaim) error {
+	base := serverClaim.DeepCopy()
+	delete(serverClaim.Annotations, AnnotationReimageBootID)
+	delete(serverClaim.Annotations, AnnotationReimagePowerCycleTime)
+	if err := r.metalClient.Patch(ctx, serverClaim, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("failed to clear reimage of ServerClaim %s: %w", client.ObjectKeyFromObject(serverClaim), err)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"context"
+	"fmt"
+
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	ctrlcache "sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// addServerEventHandlers enqueues the Nodes backed by a Server whenever the Server or its BMC changes.
+// The Servers of a BMC are looked up with the serverBMCRefField index.
+func addServerEventHandlers(ctx context.Context, targetClient client.Client, metalClient client.Client, serverInformer ctrlcache.Informer, bmcInformer ctrlcache.Informer, queue workqueue.TypedInterface[types.NamespacedName]) error {
+	enqueueServer := func(obj any) {
+		server, ok := obj.(*metalv1alpha1.Server)
+		if !ok {
+			klog.ErrorS(nil, "unexpected object type", "type", fmt.Sprintf("%T", obj))
+			return
+		}
+		enqueueNodesOfServer(ctx, targetClient, queue, server)
+	}
+	if _, err := serverInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: enqueueServer,
+		UpdateFunc: func(oldObj, newObj any) {
+			enqueueServer(newObj)
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to add server event handler: %w", err)
+	}
+
+	enqueueBMC := func(obj any) {
+		bmc, ok := obj.(*metalv1alpha1.BMC)
+		if !ok {
+			klog.ErrorS(nil, "unexpected object type", "type", fmt.Sprintf("%T", obj))
+			return
+		}
+		var servers metalv1alpha1.ServerList
+		if err := metalClient.List(ctx, &servers, client.MatchingFields{serverBMCRefField: bmc.Name}); err != nil {
+			klog.ErrorS(err, "Failed to list servers", "BMC", bmc.Name)
+			return
+		}
+		for i := range servers.Items {
+			enqueueNodesOfServer(ctx, targetClient, queue, &servers.Items[i])
+		}
+	}
+	if _, err := bmcInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: enqueueBMC,
+		UpdateFunc: func(oldObj, newObj any) {
+			enqueueBMC(newObj)
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to add bmc event handler: %w", err)
+	}
+	return nil
+}
+
+// enqueueNodesOfServer enqueues the Node registered for the ServerClaim that claims the Server.
+func enqueueNodesOfServer(ctx context.Context, targetClient client.Client, queue workqueue.TypedInterface[types.NamespacedName], server *metalv1alpha1.Server) {
+	claimRef := server.Spec.ServerClaimRef
+	if claimRef == nil {
+		return
+	}
+	enqueueNodesOfServerClaim(ctx, targetClient, queue, client.ObjectKey{Namespace: claimRef.Namespace, Name: claimRef.Name})
+}
+
+// enqueueNodesOfServerClaim enqueues the Node registered for the ServerClaim.
+func enqueueNodesOfServerClaim(ctx context.Context, targetClient client.Client, queue workqueue.TypedInterface[types.NamespacedName], serverClaimKey client.ObjectKey) {
+	providerID := buildProviderID(serverClaimKey.Namespace, serverClaimKey.Name)
+	var nodes corev1.NodeList
+	if err := targetClient.List(ctx, &nodes, client.MatchingFields{NodeProviderIDField: providerID}); err != nil {
+		klog.ErrorS(err, "Failed to list nodes", "providerID", providerID)
+		return
+	}
+	for i := range nodes.Items {
+		queue.Add(client.ObjectKeyFromObject(&nodes.Items[i]))
+	}
+}
@@ -0,0 +1,300 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlcache "sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	serverHealthControllerName = "server-health"
+
+	// bmcConditionReady is the BMC condition the metal-operator uses to report whether it can connect to the BMC.
+	bmcConditionReady = "Ready"
+
+	conditionReasonServerError      = "ServerError"
+	conditionReasonNoBMC            = "NoBMC"
+	conditionReasonBMCNotFound      = "BMCNotFound"
+	conditionReasonPowerUnknown     = "Unknown"
+	conditionReasonInMaintenance    = "InMaintenance"
+	conditionReasonNotInMaintenance = "NotInMaintenance"
+)
+
+// ServerHealthReconciler mirrors the health, BMC reachability, power state and maintenance state of
+// the Server behind a Node into Node conditions, so that tenants without access to the metal cluster
+// can see why a Node misbehaves.
+type ServerHealthReconciler struct {
+	metalClient    client.Client
+	targetClient   client.Client
+	nodeInformer   ctrlcache.Informer
+	serverInformer ctrlcache.Informer
+	bmcInformer    ctrlcache.Informer
+	serverHealth   ServerHealth
+	queue          workqueue.TypedRateLimitingInterface[types.NamespacedName]
+}
+
+func NewServerHealthReconciler(targetClient client.Client, metalClient client.Client, nodeInformer ctrlcache.Informer, serverInformer ctrlcache.Informer, bmcInformer ctrlcache.Informer, serverHealth ServerHealth) ServerHealthReconciler {
+	rateLimiter := workqueue.NewTypedItemExponentialFailureRateLimiter[types.NamespacedName](BaseReconcilerDelay, MaxReconcilerDelay)
+	queue := workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[types.NamespacedName]{
+		Name: serverHealthControllerName,
+	})
+	return ServerHealthReconciler{
+		targetClient:   targetClient,
+		metalClient:    metalClient,
+		nodeInformer:   nodeInformer,
+		serverInformer: serverInformer,
+		bmcInformer:    bmcInformer,
+		serverHealth:   serverHealth,
+		queue:          queue,
+	}
+}
+
+func (r *ServerHealthReconciler) Start(ctx context.Context) error {
+	defer r.queue.ShutDown()
+
+	if _, err := r.nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			node, ok := obj.(*corev1.Node)
+			if !ok {
+				klog.ErrorS(nil, "unexpected object type", "type", fmt.Sprintf("%T", obj))
+				return
+			}
+			r.queue.Add(client.ObjectKeyFromObject(node))
+		},
+		UpdateFunc: func(oldObj, newObj any) {
+			oldNode, oldOk := oldObj.(*corev1.Node)
+			newNode, newOk := newObj.(*corev1.Node)
+			if !oldOk || !newOk {
+				klog.ErrorS(nil, "unexpected object type", "type", fmt.Sprintf("%T", newObj))
+				return
+			}
+			if oldNode.Spec.ProviderID != newNode.Spec.ProviderID {
+				r.queue.Add(client.ObjectKeyFromObject(newNode))
+			}
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to add node event handler: %w", err)
+	}
+
+	if err := addServerEventHandlers(ctx, r.targetClient, r.metalClient, r.serverInformer, r.bmcInformer, r.queue); err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			key, quit := r.queue.Get()
+			if quit {
+				return
+			}
+
+			func() {
+				defer r.queue.Done(key)
+
+				start := time.Now()
+				err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+				observeReconcile(serverHealthControllerName, start, err)
+				if err != nil {
+					klog.ErrorS(err, "Failed to reconcile server health", "node", key)
+					r.queue.AddRateLimited(key)
+					return
+				}
+
+				r.queue.Forget(key)
+			}()
+		}
+	}()
+	<-ctx.Done()
+	klog.InfoS("Stopping server health reconciler")
+	return nil
+}
+
+func (r *ServerHealthReconciler) Reconcile(ctx context.Context, req ctrl.Request) error {
+	klog.V(2).InfoS("Reconciling server health", "node", req.NamespacedName)
+
+	node := &corev1.Node{}
+	if err := r.targetClient.Get(ctx, req.NamespacedName, node); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !node.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	serverClaimKey, err := getObjectKeyFromProviderID(node.Spec.ProviderID)
+	if err != nil {
+		klog.V(2).InfoS("Node has no valid providerID, skipping server health", "node", node.Name)
+		return nil
+	}
+	serverClaim := &metalv1alpha1.ServerClaim{}
+	if err := r.metalClient.Get(ctx, serverClaimKey, serverClaim); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if serverClaim.Spec.ServerRef == nil {
+		return nil
+	}
+	// The health of a different machine must not be reported for the Node.
+	if hasServerMismatchTaint(node) {
+		klog.V(2).InfoS("Server does not match Node, skipping server health", "node", node.Name)
+		return nil
+	}
+
+	server := &metalv1alpha1.Server{}
+	if err := r.metalClient.Get(ctx, client.ObjectKey{Name: serverClaim.Spec.ServerRef.Name}, server); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	bmcReachable, err := r.bmcReachableCondition(ctx, server)
+	if err != nil {
+		return err
+	}
+	healthy := serverHealthyCondition(server)
+	inMaintenance := serverInMaintenanceCondition(server)
+	if _, err := patchNodeConditions(ctx, r.targetClient, node, healthy, bmcReachable, serverPowerStateCondition(server), inMaintenance); err != nil {
+		return err
+	}
+
+	taints := r.serverHealth.Taints
+	base := node.DeepCopy()
+	changed := setNodeTaint(node, TaintKeyServerUnhealthy, taints.Unhealthy && healthy.Status == corev1.ConditionFalse)
+	changed = setNodeTaint(node, TaintKeyBMCUnreachable, taints.BMCUnreachable && bmcReachable.Status == corev1.ConditionFalse) || changed
+	changed = setNodeTaint(node, TaintKeyServerInMaintenance, taints.InMaintenance && inMaintenance.Status == corev1.ConditionTrue) || changed
+	if !changed {
+		return nil
+	}
+	// The taints are replaced as a whole by the merge patch, so taints added concurrently must not be lost.
+	if err := r.targetClient.Patch(ctx, node, client.MergeFromWithOptions(base, client.MergeFromWithOptimisticLock{})); err != nil {
+		return fmt.Errorf("failed to patch server health taints of Node %s: %w", node.Name, err)
+	}
+	return nil
+}
+
+// serverHealthyCondition reports a server in error state as unhealthy. The reason and message are
+// taken from the most recent failed condition of the server if there is one.
+func serverHealthyCondition(server *metalv1alpha1.Server) corev1.NodeCondition {
+	if server.Status.State != metalv1alpha1.ServerStateError {
+		return corev1.NodeCondition{
+			Type:    NodeConditionServerHealthy,
+			Status:  corev1.ConditionTrue,
+			Reason:  "Server" + string(server.Status.State),
+			Message: fmt.Sprintf("Server %s is in state %s", server.Name, server.Status.State),
+		}
+	}
+
+	condition := corev1.NodeCondition{
+		Type:    NodeConditionServerHealthy,
+		Status:  corev1.ConditionFalse,
+		Reason:  conditionReasonServerError,
+		Message: fmt.Sprintf("Server %s is in state %s", server.Name, server.Status.State),
+	}
+	var failed *metav1.Condition
+	for i := range server.Status.Conditions {
+		c := &server.Status.Conditions[i]
+		if c.Status == metav1.ConditionFalse && (failed == nil || c.LastTransitionTime.After(failed.LastTransitionTime.Time)) {
+			failed = c
+		}
+	}
+	if failed != nil {
+		if failed.Reason != "" {
+			condition.Reason = failed.Reason
+		}
+		condition.Message = fmt.Sprintf("Server %s: %s", server.Name, failed.Message)
+	}
+	return condition
+}
+
+// bmcReachableCondition copies the readiness reported by the metal-operator for the BMC of the server.
+func (r *ServerHealthReconciler) bmcReachableCondition(ctx context.Context, server *metalv1alpha1.Server) (corev1.NodeCondition, error) {
+	condition := corev1.NodeCondition{
+		Type:    NodeConditionBMCReachable,
+		Status:  corev1.ConditionUnknown,
+		Reason:  conditionReasonNoBMC,
+		Message: fmt.Sprintf("Server %s does not reference a BMC object", server.Name),
+	}
+	if server.Spec.BMCRef == nil {
+		return condition, nil
+	}
+
+	bmc := &metalv1alpha1.BMC{}
+	if err := r.metalClient.Get(ctx, client.ObjectKey{Name: server.Spec.BMCRef.Name}, bmc); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return condition, fmt.Errorf("failed to get BMC %s of server %s: %w", server.Spec.BMCRef.Name, server.Name, err)
+		}
+		condition.Status = corev1.ConditionFalse
+		condition.Reason = conditionReasonBMCNotFound
+		condition.Message = fmt.Sprintf("BMC %s of server %s does not exist", server.Spec.BMCRef.Name, server.Name)
+		return condition, nil
+	}
+
+	if ready := meta.FindStatusCondition(bmc.Status.Conditions, bmcConditionReady); ready != nil {
+		condition.Status = corev1.ConditionStatus(ready.Status)
+		condition.Reason = ready.Reason
+		condition.Message = fmt.Sprintf("BMC %s: %s", bmc.Name, ready.Message)
+		return condition, nil
+	}
+
+	condition.Reason = "BMC" + string(bmc.Status.State)
+	condition.Message = fmt.Sprintf("BMC %s is in state %s", bmc.Name, bmc.Status.State)
+	switch bmc.Status.State {
+	case metalv1alpha1.BMCStateEnabled:
+		condition.Status = corev1.ConditionTrue
+	case metalv1alpha1.BMCStateError:
+		condition.Status = corev1.ConditionFalse
+	}
+	return condition, nil
+}
+
+// serverPowerStateCondition is true if the server is powered on and carries the power state as reason.
+func serverPowerStateCondition(server *metalv1alpha1.Server) corev1.NodeCondition {
+	condition := corev1.NodeCondition{
+		Type:    NodeConditionServerPowerState,
+		Status:  corev1.ConditionUnknown,
+		Reason:  string(server.Status.PowerState),
+		Message: fmt.Sprintf("Server %s is in power state %s", server.Name, server.Status.PowerState),
+	}
+	switch server.Status.PowerState {
+	case metalv1alpha1.ServerOnPowerState:
+		condition.Status = corev1.ConditionTrue
+	case metalv1alpha1.ServerOffPowerState:
+		condition.Status = corev1.ConditionFalse
+	case "":
+		condition.Reason = conditionReasonPowerUnknown
+	}
+	return condition
+}
+
+// serverInMaintenanceCondition is true while the server is maintained by a ServerMaintenance.
+func serverInMaintenanceCondition(server *metalv1alpha1.Server) corev1.NodeCondition {
+	if server.Status.State != metalv1alpha1.ServerStateMaintenance && server.Spec.ServerMaintenanceRef == nil {
+		return corev1.NodeCondition{
+			Type:    NodeConditionServerInMaintenance,
+			Status:  corev1.ConditionFalse,
+			Reason:  conditionReasonNotInMaintenance,
+			Message: fmt.Sprintf("Server %s is not in maintenance", server.Name),
+		}
+	}
+	message := fmt.Sprintf("Server %s is in maintenance", server.Name)
+	if ref := server.Spec.ServerMaintenanceRef; ref != nil {
+		message = fmt.Sprintf("Server %s is in maintenance %s/%s", server.Name, ref.Namespace, ref.Name)
+	}
+	return corev1.NodeCondition{
+		Type:    NodeConditionServerInMaintenance,
+		Status:  corev1.ConditionTrue,
+		Reason:  conditionReasonInMaintenance,
+		Message: message,
+	}
+}
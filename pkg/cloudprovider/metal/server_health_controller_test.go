@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+)
+
+func haveNodeCondition(conditionType corev1.NodeConditionType, status corev1.ConditionStatus, reason string) types.GomegaMatcher {
+	return HaveField("Status.Conditions", ContainElement(SatisfyAll(
+		HaveField("Type", conditionType),
+		HaveField("Status", status),
+		HaveField("Reason", reason),
+	)))
+}
+
+var _ = Describe("ServerHealthReconciler", func() {
+	ns, _, _ := SetupTest(CloudConfig{
+		ClusterName: "test-cluster",
+		ServerHealth: ServerHealth{
+			Enabled: true,
+			Taints: ServerHealthTaints{
+				Unhealthy:      true,
+				BMCUnreachable: true,
+			},
+		},
+	})
+
+	var (
+		server      *metalv1alpha1.Server
+		serverClaim *metalv1alpha1.ServerClaim
+		node        *corev1.Node
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		server, serverClaim, node = createRegisteredNode(ctx, ns.Name)
+
+		By("Referencing the ServerClaim from the Server")
+		Eventually(Update(server, func() {
+			server.Spec.ServerClaimRef = &metalv1alpha1.ImmutableObjectReference{
+				Namespace: serverClaim.Namespace,
+				Name:      serverClaim.Name,
+			}
+		})).Should(Succeed())
+	})
+
+	It("should mirror the server state into Node conditions", func(ctx SpecContext) {
+		Eventually(Object(node)).Should(SatisfyAll(
+			haveNodeCondition(NodeConditionServerHealthy, corev1.ConditionTrue, "Server"),
+			haveNodeCondition(NodeConditionBMCReachable, corev1.ConditionUnknown, conditionReasonNoBMC),
+			haveNodeCondition(NodeConditionServerPowerState, corev1.ConditionTrue, string(metalv1alpha1.ServerOnPowerState)),
+			haveNodeCondition(NodeConditionServerInMaintenance, corev1.ConditionFalse, conditionReasonNotInMaintenance),
+		))
+
+		By("Moving the Server into maintenance")
+		Eventually(UpdateStatus(server, func() {
+			server.Status.State = metalv1alpha1.ServerStateMaintenance
+			server.Status.PowerState = metalv1alpha1.ServerOffPowerState
+		})).Should(Succeed())
+
+		Eventually(Object(node)).Should(SatisfyAll(
+			haveNodeCondition(NodeConditionServerPowerState, corev1.ConditionFalse, string(metalv1alpha1.ServerOffPowerState)),
+			haveNodeCondition(NodeConditionServerInMaintenance, corev1.ConditionTrue, conditionReasonInMaintenance),
+		))
+
+		By("Ensuring the Node is not tainted for maintenance without configuration")
+		Consistently(Object(node)).Should(HaveField("Spec.Taints", Not(ContainElement(HaveField("Key", TaintKeyServerInMaintenance)))))
+	})
+
+	It("should report and taint an unhealthy server", func(ctx SpecContext) {
+		By("Reporting a failed condition in the Server status")
+		Eventually(UpdateStatus(server, func() {
+			server.Status.State = metalv1alpha1.ServerStateError
+			server.Status.Conditions = []metav1.Condition{{
+				Type:               "Discovery",
+				Status:             metav1.ConditionFalse,
+				Reason:             "DiscoveryFailed",
+				Message:            "discovery timed out",
+				LastTransitionTime: metav1.Now(),
+			}}
+		})).Should(Succeed())
+
+		Eventually(Object(node)).Should(SatisfyAll(
+			haveNodeCondition(NodeConditionServerHealthy, corev1.ConditionFalse, "DiscoveryFailed"),
+			HaveField("Spec.Taints", ContainElement(HaveField("Key", TaintKeyServerUnhealthy))),
+		))
+
+		By("Recovering the Server")
+		Eventually(UpdateStatus(server, func() {
+			server.Status.State = metalv1alpha1.ServerStateReserved
+		})).Should(Succeed())
+
+		Eventually(Object(node)).Should(SatisfyAll(
+			haveNodeCondition(NodeConditionServerHealthy, corev1.ConditionTrue, "ServerReserved"),
+			HaveField("Spec.Taints", Not(ContainElement(HaveField("Key", TaintKeyServerUnhealthy)))),
+		))
+	})
+
+	It("should report and taint an unreachable BMC", func(ctx SpecContext) {
+		By("Creating a BMC that is not reachable")
+		bmc := &metalv1alpha1.BMC{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "test-",
+			},
+			Spec: metalv1alpha1.BMCSpec{
+				Endpoint: &metalv1alpha1.InlineEndpoint{
+					IP: metalv1alpha1.MustParseIP("10.0.0.10"),
+				},
+				BMCSecretRef: corev1.LocalObjectReference{Name: "bmc-secret"},
+				Protocol:     metalv1alpha1.Protocol{Name: metalv1alpha1.ProtocolRedfish, Port: 443},
+			},
+		}
+		Expect(k8sClient.Create(ctx, bmc)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, bmc)
+		Eventually(UpdateStatus(bmc, func() {
+			bmc.Status.Conditions = []metav1.Condition{{
+				Type:               bmcConditionReady,
+				Status:             metav1.ConditionFalse,
+				Reason:             "ConnectionFailed",
+				Message:            "BMC service unavailable",
+				LastTransitionTime: metav1.Now(),
+			}}
+		})).Should(Succeed())
+
+		By("Referencing the BMC from the Server")
+		Eventually(Update(server, func() {
+			server.Spec.BMCRef = &corev1.LocalObjectReference{Name: bmc.Name}
+		})).Should(Succeed())
+
+		Eventually(Object(node)).Should(SatisfyAll(
+			haveNodeCondition(NodeConditionBMCReachable, corev1.ConditionFalse, "ConnectionFailed"),
+			HaveField("Spec.Taints", ContainElement(HaveField("Key", TaintKeyBMCUnreachable))),
+		))
+
+		By("Reconnecting the BMC")
+		Eventually(UpdateStatus(bmc, func() {
+			bmc.Status.Conditions[0].Status = metav1.ConditionTrue
+			bmc.Status.Conditions[0].Reason = "Connected"
+		})).Should(Succeed())
+
+		Eventually(Object(node)).Should(SatisfyAll(
+			haveNodeCondition(NodeConditionBMCReachable, corev1.ConditionTrue, "Connected"),
+			HaveField("Spec.Taints", Not(ContainElement(HaveField("Key", TaintKeyBMCUnreachable)))),
+		))
+	})
+})
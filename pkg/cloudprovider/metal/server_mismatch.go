@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// serverMatchesNode reports whether the Server is the machine the Node runs on. A Node that did
+// not report its SystemUUID yet is assumed to match.
+func serverMatchesNode(server *metalv1alpha1.Server, node *corev1.Node) bool {
+	systemUUID := node.Status.NodeInfo.SystemUUID
+	return systemUUID == "" || strings.EqualFold(systemUUID, server.Spec.SystemUUID)
+}
+
+// verifyServerForNode returns an error if the Server bound to the ServerClaim of a Node is not the
+// machine the Node runs on, e.g. because the ServerClaim was rebound or the hardware was swapped.
+func verifyServerForNode(server *metalv1alpha1.Server, node *corev1.Node) error {
+	if serverMatchesNode(server, node) {
+		return nil
+	}
+	return fmt.Errorf("server %s has SystemUUID %s, but Node %s reports SystemUUID %s",
+		server.Name, server.Spec.SystemUUID, node.Name, node.Status.NodeInfo.SystemUUID)
+}
+
+// hasServerMismatchTaint reports whether the Node is tainted because its Server does not match.
+// Power and maintenance actions must not be applied to such a Node.
+func hasServerMismatchTaint(node *corev1.Node) bool {
+	return hasNodeTaint(node, TaintKeyServerMismatch)
+}
+
+// patchServerMismatchTaint adds or removes the server mismatch taint of a Node and reports whether
+// the Node was changed.
+func patchServerMismatchTaint(ctx context.Context, c client.Client, node *corev1.Node, mismatch bool) (bool, error) {
+	base := node.DeepCopy()
+	if !setNodeTaint(node, TaintKeyServerMismatch, mismatch) {
+		return false, nil
+	}
+	// The taints are replaced as a whole by the merge patch, so taints added concurrently must not be lost.
+	if err := c.Patch(ctx, node, client.MergeFromWithOptions(base, client.MergeFromWithOptimisticLock{})); err != nil {
+		return false, fmt.Errorf("failed to patch server mismatch taint of Node %s: %w", node.Name, err)
+	}
+	return true, nil
+}
@@ -0,0 +1,402 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlcache "sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	serverClaimBindingControllerName = "serverclaim-binding"
+
+	eventReasonClusterNameLabeled  = "ClusterNameLabeled"
+	eventReasonServerClaimMigrated = "ServerClaimMigrated"
+	eventReasonOwnedByOtherCluster = "OwnedByOtherCluster"
+	eventReasonServerMismatch      = "ServerMismatch"
+	eventReasonServerMatched       = "ServerMatched"
+
+	eventActionLabel  = "Label"
+	eventActionVerify = "Verify"
+
+	conditionReasonOwnedByCluster      = "OwnedByCluster"
+	conditionReasonOwnedByOtherCluster = "OwnedByOtherCluster"
+	conditionReasonServerClaimBound    = "Bound"
+	conditionReasonServerClaimPending  = "Pending"
+)
+
+// ServerClaimBindingReconciler binds the ServerClaim of a registered Node to the cluster and
+// verifies that its server matches the Node.
+type ServerClaimBindingReconciler struct {
+	metalClient   client.Client
+	targetClient  client.Client
+	nodeInformer  ctrlcache.Informer
+	claimInformer ctrlcache.Informer
+	// bootConfigurationInformer is nil unless the boot diagnostics are enabled.
+	bootConfigurationInformer ctrlcache.Informer
+	recorder                  events.EventRecorder
+	clusterName               string
+	metalNamespace            string
+	bootDiagnostics           BootDiagnostics
+	nodeStatusSync            NodeStatusSync
+	queue                     workqueue.TypedRateLimitingInterface[types.NamespacedName]
+}
+
+func NewServerClaimBindingReconciler(targetClient client.Client, metalClient client.Client, nodeInformer ctrlcache.Informer, claimInformer ctrlcache.Informer, metalNamespace string, bootConfigurationInformer ctrlcache.Informer, recorder events.EventRecorder, clusterName string, bootDiagnostics BootDiagnostics, nodeStatusSync NodeStatusSync) ServerClaimBindingReconciler {
+	rateLimiter := workqueue.NewTypedItemExponentialFailureRateLimiter[types.NamespacedName](BaseReconcilerDelay, MaxReconcilerDelay)
+	queue := workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[types.NamespacedName]{
+		Name: serverClaimBindingControllerName,
+	})
+	return ServerClaimBindingReconciler{
+		targetClient:              targetClient,
+		metalClient:               metalClient,
+		nodeInformer:              nodeInformer,
+		claimInformer:             claimInformer,
+		metalNamespace:            metalNamespace,
+		bootConfigurationInformer: bootConfigurationInformer,
+		recorder:                  recorder,
+		clusterName:               clusterName,
+		bootDiagnostics:           bootDiagnostics,
+		nodeStatusSync:            nodeStatusSync,
+		queue:                     queue,
+	}
+}
+
+func (r *ServerClaimBindingReconciler) Start(ctx context.Context) error {
+	defer r.queue.ShutDown()
+
+	enqueueNode := func(obj any) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		node, ok := obj.(*corev1.Node)
+		if !ok {
+			klog.ErrorS(nil, "unexpected object type", "type", fmt.Sprintf("%T", obj))
+			return
+		}
+		// Nodes without a providerID have not been registered yet and are paired with the
+		// ServerClaim of the same name until the cloud-node controller has set it.
+		if node.Spec.ProviderID == "" {
+			r.queue.Add(types.NamespacedName{Namespace: r.metalNamespace, Name: node.Name})
+			return
+		}
+		key, err := getObjectKeyFromProviderID(node.Spec.ProviderID)
+		if err != nil {
+			return
+		}
+		r.queue.Add(key)
+	}
+	if _, err := r.nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: enqueueNode,
+		UpdateFunc: func(oldObj, newObj any) {
+			enqueueNode(newObj)
+		},
+		// The status of a deleted Node is removed from its ServerClaim.
+		DeleteFunc: enqueueNode,
+	}); err != nil {
+		return fmt.Errorf("failed to add node event handler: %w", err)
+	}
+
+	enqueueClaim := func(obj any) {
+		claim, ok := obj.(*metalv1alpha1.ServerClaim)
+		if !ok {
+			klog.ErrorS(nil, "unexpected object type", "type", fmt.Sprintf("%T", obj))
+			return
+		}
+		r.queue.Add(client.ObjectKeyFromObject(claim))
+	}
+	if _, err := r.claimInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: enqueueClaim,
+		UpdateFunc: func(oldObj, newObj any) {
+			enqueueClaim(newObj)
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to add server claim event handler: %w", err)
+	}
+
+	if r.bootConfigurationInformer != nil {
+		// The ServerBootConfiguration shares its name with the ServerClaim it was created for.
+		enqueueBootConfiguration := func(obj any) {
+			config, ok := obj.(*metalv1alpha1.ServerBootConfiguration)
+			if !ok {
+				klog.ErrorS(nil, "unexpected object type", "type", fmt.Sprintf("%T", obj))
+				return
+			}
+			r.queue.Add(client.ObjectKeyFromObject(config))
+		}
+		if _, err := r.bootConfigurationInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: enqueueBootConfiguration,
+			UpdateFunc: func(oldObj, newObj any) {
+				enqueueBootConfiguration(newObj)
+			},
+		}); err != nil {
+			return fmt.Errorf("failed to add server boot configuration event handler: %w", err)
+		}
+	}
+
+	go func() {
+		for {
+			key, quit := r.queue.Get()
+			if quit {
+				return
+			}
+
+			func() {
+				defer r.queue.Done(key)
+
+				start := time.Now()
+				result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+				observeReconcile(serverClaimBindingControllerName, start, err)
+				if err != nil {
+					klog.ErrorS(err, "Failed to reconcile ServerClaim binding", "serverclaim", key)
+					r.queue.AddRateLimited(key)
+					return
+				}
+
+				r.queue.Forget(key)
+				if result.RequeueAfter > 0 {
+					r.queue.AddAfter(key, result.RequeueAfter)
+				}
+			}()
+		}
+	}()
+	<-ctx.Done()
+	klog.InfoS("Stopping ServerClaim binding reconciler")
+	return nil
+}
+
+func (r *ServerClaimBindingReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	klog.V(2).InfoS("Reconciling ServerClaim binding", "serverclaim", req.NamespacedName)
+
+	serverClaim := &metalv1alpha1.ServerClaim{}
+	if err := r.metalClient.Get(ctx, req.NamespacedName, serverClaim); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, err
+		}
+		klog.V(2).InfoS("ServerClaim not found, skipping reconciliation", "serverclaim", req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+
+	providerID := buildProviderID(serverClaim.Namespace, serverClaim.Name)
+	var nodes corev1.NodeList
+	if err := r.targetClient.List(ctx, &nodes, client.MatchingFields{NodeProviderIDField: providerID}); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list nodes with providerID %s: %w", providerID, err)
+	}
+	if len(nodes.Items) == 0 {
+		klog.V(2).InfoS("No nodes found", "providerID", providerID)
+		if err := r.clearNodeStatus(ctx, serverClaim); err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := r.reconcileUnregisteredNode(ctx, serverClaim); err != nil {
+			return ctrl.Result{}, err
+		}
+		return r.reconcileRegistrationDiagnostic(ctx, serverClaim)
+	}
+	if len(nodes.Items) > 1 {
+		return ctrl.Result{}, fmt.Errorf("multiple nodes found with providerID %s", providerID)
+	}
+	node := &nodes.Items[0]
+	if !node.DeletionTimestamp.IsZero() {
+		klog.V(2).InfoS("Node is being deleted, skipping reconciliation", "Node", node.Name)
+		return ctrl.Result{}, nil
+	}
+
+	if serverClaimOwnedByOtherCluster(serverClaim, r.clusterName) && serverClaim.Annotations[AnnotationMigrateToCluster] != r.clusterName {
+		return ctrl.Result{}, r.reportOwnedByOtherCluster(ctx, node, serverClaim)
+	}
+
+	// A ServerClaim bound to a different machine must not be adopted by the Node.
+	mismatch, err := r.reconcileServerMismatch(ctx, node, serverClaim)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if mismatch {
+		klog.InfoS("Server does not match Node, skipping adoption of the ServerClaim", "Node", node.Name, "ServerClaim", client.ObjectKeyFromObject(serverClaim))
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.ensureClusterNameLabel(ctx, node, serverClaim); err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.setRegistrationDiagnostic(ctx, serverClaim, ""); err != nil {
+		return ctrl.Result{}, err
+	}
+	result, err := r.reconcileNodeStatus(ctx, node, serverClaim)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if _, err := patchNodeConditions(ctx, r.targetClient, node, corev1.NodeCondition{
+		Type:    NodeConditionServerClaimOwned,
+		Status:  corev1.ConditionTrue,
+		Reason:  conditionReasonOwnedByCluster,
+		Message: fmt.Sprintf("ServerClaim %s belongs to cluster %s", client.ObjectKeyFromObject(serverClaim), r.clusterName),
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if _, err := patchNodeConditions(ctx, r.targetClient, node, serverClaimBoundCondition(serverClaim)); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := r.reconcileBootConfiguration(ctx, node, serverClaim); err != nil {
+		return ctrl.Result{}, err
+	}
+	return result, nil
+}
+
+// serverClaimBoundCondition reports whether the ServerClaim is bound to a Server. The phase of an
+// unbound claim is surfaced, so that operators see why the initialization of the Node is stuck.
+func serverClaimBoundCondition(serverClaim *metalv1alpha1.ServerClaim) corev1.NodeCondition {
+	if serverClaim.Spec.ServerRef != nil {
+		return corev1.NodeCondition{
+			Type:    NodeConditionServerClaimBound,
+			Status:  corev1.ConditionTrue,
+			Reason:  conditionReasonServerClaimBound,
+			Message: fmt.Sprintf("ServerClaim %s is bound to server %s", client.ObjectKeyFromObject(serverClaim), serverClaim.Spec.ServerRef.Name),
+		}
+	}
+	reason := string(serverClaim.Status.Phase)
+	if reason == "" {
+		reason = conditionReasonServerClaimPending
+	}
+	return corev1.NodeCondition{
+		Type:    NodeConditionServerClaimBound,
+		Status:  corev1.ConditionFalse,
+		Reason:  reason,
+		Message: fmt.Sprintf("ServerClaim %s is not bound to a server, phase %q", client.ObjectKeyFromObject(serverClaim), serverClaim.Status.Phase),
+	}
+}
+
+// reconcileUnregisteredNode reports an unbound ServerClaim on the Node of the same name that has not
+// been registered yet, as the cloud-node controller cannot initialize it before the claim is bound.
+// Once the claim is bound, a reported condition is updated until the Node is registered.
+func (r *ServerClaimBindingReconciler) reconcileUnregisteredNode(ctx context.Context, serverClaim *metalv1alpha1.ServerClaim) error {
+	if serverClaimOwnedByOtherCluster(serverClaim, r.clusterName) {
+		return nil
+	}
+	node := &corev1.Node{}
+	if err := r.targetClient.Get(ctx, client.ObjectKey{Name: serverClaim.Name}, node); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if node.Spec.ProviderID != "" || !node.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	if serverClaim.Spec.ServerRef != nil && getNodeCondition(node, NodeConditionServerClaimBound) == nil {
+		return nil
+	}
+	_, err := patchNodeConditions(ctx, r.targetClient, node, serverClaimBoundCondition(serverClaim))
+	return err
+}
+
+// reconcileServerMismatch taints the Node if the Server bound to its ServerClaim is not the machine
+// the Node runs on and removes the taint once they match again. It reports whether they mismatch.
+func (r *ServerClaimBindingReconciler) reconcileServerMismatch(ctx context.Context, node *corev1.Node, serverClaim *metalv1alpha1.ServerClaim) (bool, error) {
+	if serverClaim.Spec.ServerRef == nil {
+		return false, nil
+	}
+	server := &metalv1alpha1.Server{}
+	if err := r.metalClient.Get(ctx, client.ObjectKey{Name: serverClaim.Spec.ServerRef.Name}, server); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get server object for Node %s: %w", node.Name, err)
+	}
+
+	verifyErr := verifyServerForNode(server, node)
+	mismatch := verifyErr != nil
+	changed, err := patchServerMismatchTaint(ctx, r.targetClient, node, mismatch)
+	if err != nil {
+		return mismatch, err
+	}
+	if !changed {
+		return mismatch, nil
+	}
+	if mismatch {
+		klog.InfoS("Server does not match Node, tainting Node", "Node", node.Name, "ServerClaim", client.ObjectKeyFromObject(serverClaim), "Error", verifyErr)
+		r.recorder.Eventf(node, server, corev1.EventTypeWarning, eventReasonServerMismatch, eventActionVerify,
+			"ServerClaim %s is bound to a different machine, power and maintenance actions are blocked: %v", client.ObjectKeyFromObject(serverClaim), verifyErr)
+		return mismatch, nil
+	}
+	klog.InfoS("Server matches Node again, removing taint", "Node", node.Name, "ServerClaim", client.ObjectKeyFromObject(serverClaim))
+	r.recorder.Eventf(node, server, corev1.EventTypeNormal, eventReasonServerMatched, eventActionVerify,
+		"Server %s of ServerClaim %s matches the Node again", server.Name, client.ObjectKeyFromObject(serverClaim))
+	return mismatch, nil
+}
+
+// serverClaimOwnedByOtherCluster reports whether the ServerClaim is labelled for a cluster other than the given one.
+func serverClaimOwnedByOtherCluster(serverClaim *metalv1alpha1.ServerClaim, clusterName string) bool {
+	owner, ok := serverClaim.Labels[LabelKeyClusterName]
+	return ok && owner != clusterName
+}
+
+// reportOwnedByOtherCluster marks a Node whose ServerClaim belongs to another cluster. The ServerClaim
+// itself is left untouched so that the owning cluster keeps full control over it.
+func (r *ServerClaimBindingReconciler) reportOwnedByOtherCluster(ctx context.Context, node *corev1.Node, serverClaim *metalv1alpha1.ServerClaim) error {
+	owner := serverClaim.Labels[LabelKeyClusterName]
+	klog.InfoS("ServerClaim is owned by another cluster, refusing to adopt it", "Node", node.Name, "ServerClaim", client.ObjectKeyFromObject(serverClaim), "Owner", owner)
+
+	changed, err := patchNodeConditions(ctx, r.targetClient, node, corev1.NodeCondition{
+		Type:   NodeConditionServerClaimOwned,
+		Status: corev1.ConditionFalse,
+		Reason: conditionReasonOwnedByOtherCluster,
+		Message: fmt.Sprintf("ServerClaim %s belongs to cluster %s, set annotation %s=%s on it to hand it over",
+			client.ObjectKeyFromObject(serverClaim), owner, AnnotationMigrateToCluster, r.clusterName),
+	})
+	if err != nil {
+		return err
+	}
+	if changed {
+		r.recorder.Eventf(node, nil, corev1.EventTypeWarning, eventReasonOwnedByOtherCluster, eventActionLabel,
+			"ServerClaim %s belongs to cluster %s", client.ObjectKeyFromObject(serverClaim), owner)
+	}
+	return nil
+}
+
+// ensureClusterNameLabel labels the ServerClaim with the name of the cluster its Node belongs to.
+// A pending migration to this cluster is completed by removing the migration annotation.
+func (r *ServerClaimBindingReconciler) ensureClusterNameLabel(ctx context.Context, node *corev1.Node, serverClaim *metalv1alpha1.ServerClaim) error {
+	migrating := serverClaim.Annotations[AnnotationMigrateToCluster] == r.clusterName
+	if serverClaim.Labels[LabelKeyClusterName] == r.clusterName && !migrating {
+		return nil
+	}
+
+	previousOwner := serverClaim.Labels[LabelKeyClusterName]
+	klog.V(2).InfoS("Adding cluster name label to server claim object", "ServerClaim", client.ObjectKeyFromObject(serverClaim), "Node", node.Name)
+	serverClaimBase := serverClaim.DeepCopy()
+	if serverClaim.Labels == nil {
+		serverClaim.Labels = make(map[string]string)
+	}
+	serverClaim.Labels[LabelKeyClusterName] = r.clusterName
+	if migrating {
+		delete(serverClaim.Annotations, AnnotationMigrateToCluster)
+	}
+	// A stale ServerClaim must not overwrite the label of a cluster that labelled it in the meantime.
+	if err := r.metalClient.Patch(ctx, serverClaim, client.MergeFromWithOptions(serverClaimBase, client.MergeFromWithOptimisticLock{})); err != nil {
+		return fmt.Errorf("failed to patch server claim for Node %s: %w", node.Name, err)
+	}
+	serverClaimPatchesTotal.WithLabelValues(serverClaimBindingControllerName, "cluster-name").Inc()
+
+	if previousOwner != "" && previousOwner != r.clusterName {
+		r.recorder.Eventf(node, nil, corev1.EventTypeNormal, eventReasonServerClaimMigrated, eventActionLabel,
+			"Took over ServerClaim %s from cluster %s", client.ObjectKeyFromObject(serverClaim), previousOwner)
+		return nil
+	}
+	r.recorder.Eventf(node, nil, corev1.EventTypeNormal, eventReasonClusterNameLabeled, eventActionLabel,
+		"Labeled ServerClaim %s with cluster name %s", client.ObjectKeyFromObject(serverClaim), r.clusterName)
+	return nil
+}
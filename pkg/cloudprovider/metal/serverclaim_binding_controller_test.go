@@ -0,0 +1,474 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"time"
+
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+)
+
+var _ = Describe("ServerClaimBindingReconciler", func() {
+
+	var (
+		serverClaim *metalv1alpha1.ServerClaim
+		node        *corev1.Node
+	)
+
+	ns, cp, clusterName := SetupTest(CloudConfig{
+		ClusterName: "test-cluster",
+	})
+
+	BeforeEach(func(ctx SpecContext) {
+		var ok bool
+		instancesProvider, ok = (*cp).InstancesV2()
+		Expect(ok).To(BeTrue())
+
+		By("Creating a Server")
+		server := &metalv1alpha1.Server{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "test-",
+			},
+			Spec: metalv1alpha1.ServerSpec{
+				SystemUUID: "9876",
+				Power:      "On",
+			},
+		}
+		Expect(k8sClient.Create(ctx, server)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, server)
+
+		By("Patching the Server object to be powered on")
+		Eventually(UpdateStatus(server, func() {
+			server.Status.PowerState = metalv1alpha1.ServerOnPowerState
+		})).Should(Succeed())
+
+		By("Creating a ServerClaim for a Node")
+		serverClaim = &metalv1alpha1.ServerClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-",
+			},
+			Spec: metalv1alpha1.ServerClaimSpec{
+				Power:     "On",
+				ServerRef: &corev1.LocalObjectReference{Name: server.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, serverClaim)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, serverClaim)
+
+		By("Creating a Node object")
+		node = &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "test-",
+			},
+		}
+		Expect(k8sClient.Create(ctx, node)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, node)
+
+		By("Updating the SystemUUID in Node status")
+		Eventually(UpdateStatus(node, func() {
+			node.Status.NodeInfo.SystemUUID = "9876"
+		})).Should(Succeed())
+
+		By("Ensuring the ServerClaim is not bound before the Node is registered")
+		Consistently(Object(serverClaim)).Should(HaveField("Labels", BeEmpty()))
+
+		By("Setting the provider ID from the instance metadata")
+		meta, err := instancesProvider.InstanceMetadata(ctx, node)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(meta).NotTo(BeNil())
+
+		originalNode := node.DeepCopy()
+		node.Spec.ProviderID = meta.ProviderID
+		Expect(k8sClient.Patch(ctx, node, client.MergeFrom(originalNode))).To(Succeed())
+	})
+
+	It("should add the cluster name label to the ServerClaim", func(ctx SpecContext) {
+		Eventually(Object(serverClaim)).Should(HaveField("Labels", map[string]string{LabelKeyClusterName: clusterName}))
+	})
+
+	It("should taint the Node and block power control if the server does not match", func(ctx SpecContext) {
+		Eventually(Object(serverClaim)).Should(HaveField("Labels", HaveKeyWithValue(LabelKeyClusterName, clusterName)))
+
+		By("Reporting a different SystemUUID on the Node")
+		Eventually(UpdateStatus(node, func() {
+			node.Status.NodeInfo.SystemUUID = "4711"
+		})).Should(Succeed())
+
+		By("Ensuring the Node is tainted")
+		Eventually(Object(node)).Should(HaveField("Spec.Taints", ContainElement(HaveField("Key", TaintKeyServerMismatch))))
+
+		By("Ensuring the instance metadata is refused")
+		_, err := instancesProvider.InstanceMetadata(ctx, node)
+		Expect(err).To(HaveOccurred())
+
+		By("Annotating the node with power off")
+		Eventually(Update(node, func() {
+			node.Annotations = map[string]string{
+				AnnotationPowerOff: "true",
+			}
+		})).Should(Succeed())
+		Consistently(Object(serverClaim)).Should(HaveField("Spec.Power", metalv1alpha1.PowerOn))
+
+		By("Reporting the matching SystemUUID again")
+		Eventually(UpdateStatus(node, func() {
+			node.Status.NodeInfo.SystemUUID = "9876"
+		})).Should(Succeed())
+
+		By("Ensuring the taint is removed and power control resumes")
+		Eventually(Object(node)).Should(HaveField("Spec.Taints", Not(ContainElement(HaveField("Key", TaintKeyServerMismatch)))))
+		Eventually(Object(serverClaim)).Should(HaveField("Spec.Power", metalv1alpha1.PowerOff))
+	})
+
+	It("should not adopt a ServerClaim bound to a different machine", func(ctx SpecContext) {
+		Eventually(Object(serverClaim)).Should(HaveField("Labels", HaveKeyWithValue(LabelKeyClusterName, clusterName)))
+
+		By("Reporting a different SystemUUID on the Node")
+		Eventually(UpdateStatus(node, func() {
+			node.Status.NodeInfo.SystemUUID = "4711"
+		})).Should(Succeed())
+		Eventually(Object(node)).Should(HaveField("Spec.Taints", ContainElement(HaveField("Key", TaintKeyServerMismatch))))
+
+		By("Removing the cluster name label from the ServerClaim")
+		Eventually(Update(serverClaim, func() {
+			delete(serverClaim.Labels, LabelKeyClusterName)
+		})).Should(Succeed())
+
+		By("Ensuring the ServerClaim is not labelled again")
+		Consistently(Object(serverClaim)).Should(HaveField("Labels", Not(HaveKey(LabelKeyClusterName))))
+	})
+
+	Context("ServerClaim owned by another cluster", func() {
+		BeforeEach(func(ctx SpecContext) {
+			By("Waiting for the ServerClaim to be adopted")
+			Eventually(Object(serverClaim)).Should(HaveField("Labels", HaveKeyWithValue(LabelKeyClusterName, clusterName)))
+
+			By("Labelling the ServerClaim for another cluster")
+			Eventually(Update(serverClaim, func() {
+				serverClaim.Labels[LabelKeyClusterName] = "other-cluster"
+			})).Should(Succeed())
+		})
+
+		It("should not adopt or power control the ServerClaim", func(ctx SpecContext) {
+			By("Ensuring the Node reports the foreign ownership")
+			Eventually(Object(node)).Should(HaveField("Status.Conditions", ContainElement(SatisfyAll(
+				HaveField("Type", NodeConditionServerClaimOwned),
+				HaveField("Status", corev1.ConditionFalse),
+				HaveField("Reason", conditionReasonOwnedByOtherCluster),
+			))))
+
+			By("Annotating the node with power off")
+			Eventually(Update(node, func() {
+				node.Annotations = map[string]string{
+					AnnotationPowerOff: "true",
+				}
+			})).Should(Succeed())
+
+			By("Ensuring the ServerClaim is left untouched")
+			Consistently(Object(serverClaim)).Should(SatisfyAll(
+				HaveField("Labels", HaveKeyWithValue(LabelKeyClusterName, "other-cluster")),
+				HaveField("Spec.Power", metalv1alpha1.PowerOn),
+			))
+		})
+
+		It("should take over the ServerClaim if it is migrated to this cluster", func(ctx SpecContext) {
+			Eventually(Object(node)).Should(HaveField("Status.Conditions", ContainElement(
+				HaveField("Status", corev1.ConditionFalse),
+			)))
+
+			By("Annotating the ServerClaim for migration")
+			Eventually(Update(serverClaim, func() {
+				serverClaim.Annotations = map[string]string{
+					AnnotationMigrateToCluster: clusterName,
+				}
+			})).Should(Succeed())
+
+			By("Ensuring the ServerClaim is labelled for this cluster")
+			Eventually(Object(serverClaim)).Should(SatisfyAll(
+				HaveField("Labels", HaveKeyWithValue(LabelKeyClusterName, clusterName)),
+				HaveField("Annotations", Not(HaveKey(AnnotationMigrateToCluster))),
+			))
+
+			By("Ensuring the Node reports the ownership")
+			Eventually(Object(node)).Should(HaveField("Status.Conditions", ContainElement(SatisfyAll(
+				HaveField("Type", NodeConditionServerClaimOwned),
+				HaveField("Status", corev1.ConditionTrue),
+			))))
+		})
+
+		It("should ignore a migration to a different cluster", func(ctx SpecContext) {
+			By("Annotating the ServerClaim for migration to a third cluster")
+			Eventually(Update(serverClaim, func() {
+				serverClaim.Annotations = map[string]string{
+					AnnotationMigrateToCluster: "third-cluster",
+				}
+			})).Should(Succeed())
+
+			Consistently(Object(serverClaim)).Should(SatisfyAll(
+				HaveField("Labels", HaveKeyWithValue(LabelKeyClusterName, "other-cluster")),
+				HaveField("Annotations", HaveKeyWithValue(AnnotationMigrateToCluster, "third-cluster")),
+			))
+		})
+	})
+})
+
+var _ = Describe("ServerClaimBindingReconciler with boot diagnostics", func() {
+	ns, _, _ := SetupTest(CloudConfig{
+		ClusterName: "test-cluster",
+		BootDiagnostics: BootDiagnostics{
+			Enabled:             true,
+			RegistrationTimeout: metav1.Duration{Duration: time.Second},
+			ServerClaimSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"example.com/pool": "test"},
+			},
+		},
+	})
+
+	createBootConfiguration := func(ctx SpecContext, serverClaim *metalv1alpha1.ServerClaim) *metalv1alpha1.ServerBootConfiguration {
+		config := &metalv1alpha1.ServerBootConfiguration{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: serverClaim.Namespace,
+				Name:      serverClaim.Name,
+			},
+			Spec: metalv1alpha1.ServerBootConfigurationSpec{
+				ServerRef: *serverClaim.Spec.ServerRef,
+				Image:     "example.com/os:1.0",
+			},
+		}
+		Expect(k8sClient.Create(ctx, config)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, config)
+		return config
+	}
+
+	It("should report the state of the boot configuration on the Node", func(ctx SpecContext) {
+		_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+		config := createBootConfiguration(ctx, serverClaim)
+
+		By("Failing the boot configuration")
+		Eventually(UpdateStatus(config, func() {
+			config.Status.State = metalv1alpha1.ServerBootConfigurationStateError
+			config.Status.Conditions = []metav1.Condition{{
+				Type:               "ImageValidation",
+				Status:             metav1.ConditionFalse,
+				Reason:             "ImageNotFound",
+				Message:            "image example.com/os:1.0 not found",
+				LastTransitionTime: metav1.Now(),
+			}}
+		})).Should(Succeed())
+		Eventually(Object(node)).Should(SatisfyAll(
+			haveNodeCondition(NodeConditionBootConfiguration, corev1.ConditionFalse, string(metalv1alpha1.ServerBootConfigurationStateError)),
+			HaveField("Status.Conditions", ContainElement(HaveField("Message", ContainSubstring("image example.com/os:1.0 not found")))),
+		))
+
+		By("Making the boot configuration ready")
+		Eventually(UpdateStatus(config, func() {
+			config.Status.State = metalv1alpha1.ServerBootConfigurationStateReady
+			config.Status.Conditions = nil
+		})).Should(Succeed())
+		Eventually(Object(node)).Should(
+			haveNodeCondition(NodeConditionBootConfiguration, corev1.ConditionTrue, string(metalv1alpha1.ServerBootConfigurationStateReady)))
+	})
+
+	createUnregisteredServerClaim := func(ctx SpecContext, labels map[string]string) (*metalv1alpha1.Server, *metalv1alpha1.ServerClaim) {
+		server := &metalv1alpha1.Server{
+			ObjectMeta: metav1.ObjectMeta{GenerateName: "test-"},
+			Spec:       metalv1alpha1.ServerSpec{SystemUUID: "54321"},
+		}
+		Expect(k8sClient.Create(ctx, server)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, server)
+		serverClaim := &metalv1alpha1.ServerClaim{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns.Name, GenerateName: "test-", Labels: labels},
+			Spec: metalv1alpha1.ServerClaimSpec{
+				Power:     "On",
+				ServerRef: &corev1.LocalObjectReference{Name: server.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, serverClaim)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, serverClaim)
+		config := createBootConfiguration(ctx, serverClaim)
+		Eventually(UpdateStatus(config, func() {
+			config.Status.State = metalv1alpha1.ServerBootConfigurationStatePending
+		})).Should(Succeed())
+		return server, serverClaim
+	}
+
+	It("should diagnose a ServerClaim whose Node does not register in time", func(ctx SpecContext) {
+		server, serverClaim := createUnregisteredServerClaim(ctx, map[string]string{"example.com/pool": "test"})
+
+		Eventually(Object(serverClaim)).Should(HaveField("Annotations", HaveKeyWithValue(AnnotationRegistrationDiagnostic,
+			SatisfyAll(ContainSubstring(server.Name), ContainSubstring("is Pending")))))
+
+		By("Registering the Node")
+		node := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{GenerateName: "test-"},
+			Spec:       corev1.NodeSpec{ProviderID: buildProviderID(serverClaim.Namespace, serverClaim.Name)},
+		}
+		Expect(k8sClient.Create(ctx, node)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, node)
+		Eventually(Object(serverClaim)).Should(HaveField("Annotations", Not(HaveKey(AnnotationRegistrationDiagnostic))))
+	})
+
+	It("should only diagnose ServerClaims that belong to the cluster", func(ctx SpecContext) {
+		_, ownClaim := createUnregisteredServerClaim(ctx, map[string]string{LabelKeyClusterName: "test-cluster"})
+		_, unselectedClaim := createUnregisteredServerClaim(ctx, nil)
+		_, otherClaim := createUnregisteredServerClaim(ctx, map[string]string{
+			LabelKeyClusterName: "other-cluster",
+			"example.com/pool":  "test",
+		})
+
+		Eventually(Object(ownClaim)).Should(HaveField("Annotations", HaveKey(AnnotationRegistrationDiagnostic)))
+		Consistently(Object(unselectedClaim)).Should(HaveField("Annotations", Not(HaveKey(AnnotationRegistrationDiagnostic))))
+		Expect(Object(otherClaim)()).To(HaveField("Annotations", Not(HaveKey(AnnotationRegistrationDiagnostic))))
+	})
+})
+
+var _ = Describe("ServerClaimBindingReconciler with boot diagnostics without a ServerClaim selector", func() {
+	ns, _, _ := SetupTest(CloudConfig{
+		ClusterName: "test-cluster",
+		BootDiagnostics: BootDiagnostics{
+			Enabled:             true,
+			RegistrationTimeout: metav1.Duration{Duration: time.Second},
+		},
+	})
+
+	It("should diagnose the ServerClaims of the metal namespace that are not labelled for another cluster", func(ctx SpecContext) {
+		createServerClaim := func(labels map[string]string) *metalv1alpha1.ServerClaim {
+			server := &metalv1alpha1.Server{
+				ObjectMeta: metav1.ObjectMeta{GenerateName: "test-"},
+				Spec:       metalv1alpha1.ServerSpec{SystemUUID: "54321"},
+			}
+			Expect(k8sClient.Create(ctx, server)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, server)
+			serverClaim := &metalv1alpha1.ServerClaim{
+				ObjectMeta: metav1.ObjectMeta{Namespace: ns.Name, GenerateName: "test-", Labels: labels},
+				Spec: metalv1alpha1.ServerClaimSpec{
+					Power:     "On",
+					ServerRef: &corev1.LocalObjectReference{Name: server.Name},
+				},
+			}
+			Expect(k8sClient.Create(ctx, serverClaim)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, serverClaim)
+			return serverClaim
+		}
+		unlabelledClaim := createServerClaim(nil)
+		otherClaim := createServerClaim(map[string]string{LabelKeyClusterName: "other-cluster"})
+
+		Eventually(Object(unlabelledClaim)).Should(HaveField("Annotations", HaveKeyWithValue(AnnotationRegistrationDiagnostic,
+			ContainSubstring("No Node registered"))))
+		Consistently(Object(otherClaim)).Should(HaveField("Annotations", Not(HaveKey(AnnotationRegistrationDiagnostic))))
+	})
+})
+
+var _ = Describe("ServerClaimBindingReconciler with node status sync", func() {
+	ns, _, _ := SetupTest(CloudConfig{
+		ClusterName: "test-cluster",
+		NodeStatusSync: NodeStatusSync{
+			Enabled:           true,
+			HeartbeatInterval: metav1.Duration{Duration: time.Hour},
+		},
+	})
+
+	It("should report the status of the Node on the ServerClaim", func(ctx SpecContext) {
+		_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+
+		By("Reporting the Node as ready")
+		heartbeat := metav1.NewTime(time.Now().Truncate(time.Second))
+		Eventually(UpdateStatus(node, func() {
+			node.Status.NodeInfo.KubeletVersion = "v1.35.0"
+			node.Status.Conditions = append(node.Status.Conditions, corev1.NodeCondition{
+				Type:               corev1.NodeReady,
+				Status:             corev1.ConditionTrue,
+				Reason:             "KubeletReady",
+				LastHeartbeatTime:  heartbeat,
+				LastTransitionTime: heartbeat,
+			})
+		})).Should(Succeed())
+		Eventually(Object(serverClaim)).Should(HaveField("Annotations", SatisfyAll(
+			HaveKeyWithValue(AnnotationNodeName, node.Name),
+			HaveKeyWithValue(AnnotationNodeReady, string(corev1.ConditionTrue)),
+			HaveKeyWithValue(AnnotationNodeKubeletVersion, "v1.35.0"),
+			HaveKeyWithValue(AnnotationNodeUnschedulable, "false"),
+			HaveKeyWithValue(AnnotationNodeLastHeartbeat, heartbeat.UTC().Format(time.RFC3339)),
+		)))
+
+		By("Advancing only the heartbeat")
+		Eventually(UpdateStatus(node, func() {
+			for i := range node.Status.Conditions {
+				if node.Status.Conditions[i].Type == corev1.NodeReady {
+					node.Status.Conditions[i].LastHeartbeatTime = metav1.NewTime(heartbeat.Add(time.Minute))
+				}
+			}
+		})).Should(Succeed())
+		Consistently(Object(serverClaim)).Should(HaveField("Annotations",
+			HaveKeyWithValue(AnnotationNodeLastHeartbeat, heartbeat.UTC().Format(time.RFC3339))))
+
+		By("Cordoning the Node")
+		Eventually(Update(node, func() {
+			node.Spec.Unschedulable = true
+		})).Should(Succeed())
+		Eventually(Object(serverClaim)).Should(HaveField("Annotations", SatisfyAll(
+			HaveKeyWithValue(AnnotationNodeUnschedulable, "true"),
+			HaveKeyWithValue(AnnotationNodeLastHeartbeat, heartbeat.Add(time.Minute).UTC().Format(time.RFC3339)),
+		)))
+
+		By("Deleting the Node")
+		Expect(k8sClient.Delete(ctx, node)).To(Succeed())
+		Eventually(Object(serverClaim)).Should(HaveField("Annotations", SatisfyAll(
+			Not(HaveKey(AnnotationNodeName)),
+			Not(HaveKey(AnnotationNodeReady)),
+			Not(HaveKey(AnnotationNodeKubeletVersion)),
+			Not(HaveKey(AnnotationNodeUnschedulable)),
+			Not(HaveKey(AnnotationNodeLastHeartbeat)),
+		)))
+	})
+})
+
+var _ = Describe("ServerClaimBindingReconciler with node status sync and a short heartbeat interval", func() {
+	const heartbeatInterval = 5 * time.Second
+
+	ns, _, _ := SetupTest(CloudConfig{
+		ClusterName: "test-cluster",
+		NodeStatusSync: NodeStatusSync{
+			Enabled:           true,
+			HeartbeatInterval: metav1.Duration{Duration: heartbeatInterval},
+		},
+	})
+
+	It("should write a withheld heartbeat once the heartbeat interval passed", func(ctx SpecContext) {
+		_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+
+		By("Reporting the Node as ready")
+		heartbeat := metav1.NewTime(time.Now().Truncate(time.Second))
+		Eventually(UpdateStatus(node, func() {
+			node.Status.Conditions = append(node.Status.Conditions, corev1.NodeCondition{
+				Type:               corev1.NodeReady,
+				Status:             corev1.ConditionTrue,
+				LastHeartbeatTime:  heartbeat,
+				LastTransitionTime: heartbeat,
+			})
+		})).Should(Succeed())
+		Eventually(Object(serverClaim)).Should(HaveField("Annotations",
+			HaveKeyWithValue(AnnotationNodeLastHeartbeat, heartbeat.UTC().Format(time.RFC3339))))
+
+		By("Advancing only the heartbeat")
+		next := metav1.NewTime(heartbeat.Add(time.Second))
+		Eventually(UpdateStatus(node, func() {
+			for i := range node.Status.Conditions {
+				if node.Status.Conditions[i].Type == corev1.NodeReady {
+					node.Status.Conditions[i].LastHeartbeatTime = next
+				}
+			}
+		})).Should(Succeed())
+		Consistently(Object(serverClaim)).Should(HaveField("Annotations",
+			HaveKeyWithValue(AnnotationNodeLastHeartbeat, heartbeat.UTC().Format(time.RFC3339))))
+		Eventually(Object(serverClaim)).WithTimeout(heartbeatInterval + eventuallyTimeout).Should(HaveField("Annotations",
+			HaveKeyWithValue(AnnotationNodeLastHeartbeat, next.UTC().Format(time.RFC3339))))
+	})
+})
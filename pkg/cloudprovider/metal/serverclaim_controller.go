@@ -6,39 +6,91 @@ package metal
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	ctrlcache "sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 )
 
+const (
+	serverClaimDrainFinalizer = "metal.ironcore.dev/drain-node"
+
+	eventReasonDrainingNode = "DrainingNode"
+	eventReasonNodeDrained  = "NodeDrained"
+	eventReasonDrainTimeout = "DrainTimeout"
+
+	eventActionDrain = "Drain"
+)
+
 type ServerClaimReconciler struct {
-	metalClient  client.Client
-	targetClient client.Client
-	informer     ctrlcache.Informer
-	queue        workqueue.TypedRateLimitingInterface[types.NamespacedName]
+	metalClient   client.Client
+	targetClient  client.Client
+	nodeInformer  ctrlcache.Informer
+	claimInformer ctrlcache.Informer
+	recorder      events.EventRecorder
+	drainer       nodeDrainer
+	cloudConfig   CloudConfig
+	queue         workqueue.TypedRateLimitingInterface[types.NamespacedName]
 }
 
-func NewServerClaimReconciler(targetClient client.Client, metalClient client.Client, claimInformer ctrlcache.Informer) ServerClaimReconciler {
+func NewServerClaimReconciler(targetClient client.Client, targetReader client.Reader, metalClient client.Client, nodeInformer ctrlcache.Informer, claimInformer ctrlcache.Informer, recorder events.EventRecorder, cloudConfig CloudConfig) ServerClaimReconciler {
 	rateLimiter := workqueue.NewTypedItemExponentialFailureRateLimiter[types.NamespacedName](BaseReconcilerDelay, MaxReconcilerDelay)
 	queue := workqueue.NewTypedRateLimitingQueue(rateLimiter)
 	return ServerClaimReconciler{
-		targetClient: targetClient,
-		metalClient:  metalClient,
-		informer:     claimInformer,
-		queue:        queue,
+		targetClient:  targetClient,
+		metalClient:   metalClient,
+		nodeInformer:  nodeInformer,
+		claimInformer: claimInformer,
+		recorder:      recorder,
+		drainer:       newNodeDrainer(targetClient, targetReader),
+		cloudConfig:   cloudConfig,
+		queue:         queue,
 	}
 }
 
 func (r *ServerClaimReconciler) Start(ctx context.Context) error {
-	_, err := r.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+	defer r.queue.ShutDown()
+
+	// The finalizer follows the registration and deletion of the Node of a ServerClaim, so Nodes
+	// have to be watched for those changes as well.
+	enqueueNode := func(obj any) {
+		node, ok := obj.(*corev1.Node)
+		if !ok {
+			klog.ErrorS(nil, "unexpected object type", "type", fmt.Sprintf("%T", obj))
+			return
+		}
+		key, err := getObjectKeyFromProviderID(node.Spec.ProviderID)
+		if err != nil {
+			return
+		}
+		r.queue.Add(key)
+	}
+	if _, err := r.nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: enqueueNode,
+		UpdateFunc: func(oldObj, newObj any) {
+			oldNode, oldOk := oldObj.(*corev1.Node)
+			newNode, newOk := newObj.(*corev1.Node)
+			if oldOk && newOk && oldNode.Spec.ProviderID == newNode.Spec.ProviderID &&
+				oldNode.DeletionTimestamp.IsZero() == newNode.DeletionTimestamp.IsZero() {
+				return
+			}
+			enqueueNode(newObj)
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to add node event handler: %w", err)
+	}
+
+	_, err := r.claimInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj any) {
 			claim, ok := obj.(*metalv1alpha1.ServerClaim)
 			if !ok {
@@ -64,12 +116,9 @@ func (r *ServerClaimReconciler) Start(ctx context.Context) error {
 				klog.ErrorS(nil, "unexpected object type", "type", fmt.Sprintf("%T", obj))
 				return
 			}
-			key := client.ObjectKeyFromObject(claim)
-			r.queue.Forget(key)
-			r.queue.Done(key)
+			r.queue.Forget(client.ObjectKeyFromObject(claim))
 		},
 	})
-	defer r.queue.ShutDown()
 	if err != nil {
 		return fmt.Errorf("failed to add event handler: %w", err)
 	}
@@ -79,11 +128,22 @@ func (r *ServerClaimReconciler) Start(ctx context.Context) error {
 			if quit {
 				return
 			}
-			if err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
-				klog.ErrorS(err, "Failed to reconcile ServerClaim", "serverclaim", key)
-				r.queue.AddRateLimited(key)
-			}
-			r.queue.Done(key)
+
+			func() {
+				defer r.queue.Done(key)
+
+				result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+				if err != nil {
+					klog.ErrorS(err, "Failed to reconcile ServerClaim", "serverclaim", key)
+					r.queue.AddRateLimited(key)
+					return
+				}
+
+				r.queue.Forget(key)
+				if result.RequeueAfter > 0 {
+					r.queue.AddAfter(key, result.RequeueAfter)
+				}
+			}()
 		}
 	}()
 	<-ctx.Done()
@@ -91,32 +151,44 @@ func (r *ServerClaimReconciler) Start(ctx context.Context) error {
 	return nil
 }
 
-func (r *ServerClaimReconciler) Reconcile(ctx context.Context, req ctrl.Request) error {
+func (r *ServerClaimReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	klog.V(2).InfoS("Reconciling ServerClaim", "serverclaim", req.NamespacedName)
 
 	serverClaim := &metalv1alpha1.ServerClaim{}
 	if err := r.metalClient.Get(ctx, req.NamespacedName, serverClaim); err != nil {
 		if client.IgnoreNotFound(err) != nil {
-			return err
+			return ctrl.Result{}, err
 		}
 		klog.V(2).InfoS("ServerClaim not found, skipping reconciliation", "serverclaim", req.NamespacedName)
-		return nil
+		return ctrl.Result{}, nil
 	}
 
-	providerID := fmt.Sprintf("%s://%s/%s", ProviderName, serverClaim.Namespace, serverClaim.Name)
+	providerID := buildProviderID(serverClaim.Namespace, serverClaim.Name)
 	var nodes corev1.NodeList
 	err := r.targetClient.List(ctx, &nodes, client.MatchingFields{NodeProviderIDField: providerID})
 	if err != nil {
-		return fmt.Errorf("failed to list nodes with providerID %s: %w", providerID, err)
-	}
-	if len(nodes.Items) == 0 {
-		klog.V(2).InfoS("No nodes found", "providerID", providerID)
-		return nil
+		return ctrl.Result{}, fmt.Errorf("failed to list nodes with providerID %s: %w", providerID, err)
 	}
 	if len(nodes.Items) > 1 {
-		return fmt.Errorf("multiple nodes found with providerID %s", providerID)
+		return ctrl.Result{}, fmt.Errorf("multiple nodes found with providerID %s", providerID)
+	}
+	var node *corev1.Node
+	if len(nodes.Items) == 1 {
+		node = &nodes.Items[0]
+	}
+
+	if !serverClaim.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, serverClaim, node)
+	}
+
+	if err := r.reconcileDrainFinalizer(ctx, serverClaim, node); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if node == nil {
+		klog.V(2).InfoS("No nodes found", "providerID", providerID)
+		return ctrl.Result{}, nil
 	}
-	node := nodes.Items[0]
 	if node.Labels == nil {
 		node.Labels = make(map[string]string)
 	}
@@ -127,5 +199,73 @@ func (r *ServerClaimReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	} else {
 		delete(node.Labels, metalv1alpha1.ServerMaintenanceNeededLabelKey)
 	}
-	return r.targetClient.Patch(ctx, &node, client.MergeFrom(originalNode))
+	// The metadata of a ServerClaim owned by another cluster is not meant for this Node.
+	if !serverClaimOwnedByOtherCluster(serverClaim, r.cloudConfig.ClusterName) {
+		propagateMetadata(serverClaim, node, r.cloudConfig.Propagation)
+	}
+	return ctrl.Result{}, r.targetClient.Patch(ctx, node, client.MergeFrom(originalNode))
+}
+
+// reconcileDrainFinalizer protects the ServerClaim of a registered Node with a finalizer, so that the
+// Node is drained before the server is released.
+func (r *ServerClaimReconciler) reconcileDrainFinalizer(ctx context.Context, serverClaim *metalv1alpha1.ServerClaim, node *corev1.Node) error {
+	protect := r.cloudConfig.ServerClaimProtection.Enabled &&
+		node != nil && node.DeletionTimestamp.IsZero() &&
+		!serverClaimOwnedByOtherCluster(serverClaim, r.cloudConfig.ClusterName)
+	if protect {
+		return r.patchDrainFinalizer(ctx, serverClaim, controllerutil.AddFinalizer)
+	}
+	return r.patchDrainFinalizer(ctx, serverClaim, controllerutil.RemoveFinalizer)
+}
+
+// reconcileDelete drains the Node of a ServerClaim that is being deleted and removes the finalizer
+// once the Node is drained or the drain timed out.
+func (r *ServerClaimReconciler) reconcileDelete(ctx context.Context, serverClaim *metalv1alpha1.ServerClaim, node *corev1.Node) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(serverClaim, serverClaimDrainFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	if node == nil || !node.DeletionTimestamp.IsZero() || serverClaimOwnedByOtherCluster(serverClaim, r.cloudConfig.ClusterName) {
+		klog.V(2).InfoS("No Node to drain, releasing ServerClaim", "ServerClaim", client.ObjectKeyFromObject(serverClaim))
+		return ctrl.Result{}, r.patchDrainFinalizer(ctx, serverClaim, controllerutil.RemoveFinalizer)
+	}
+
+	timeout := r.cloudConfig.ServerClaimProtection.GetDrainTimeout()
+	remaining := time.Until(serverClaim.DeletionTimestamp.Add(timeout))
+	if remaining <= 0 {
+		klog.InfoS("Timed out draining Node, releasing ServerClaim", "Node", node.Name, "ServerClaim", client.ObjectKeyFromObject(serverClaim), "Timeout", timeout)
+		r.recorder.Eventf(node, serverClaim, corev1.EventTypeWarning, eventReasonDrainTimeout, eventActionDrain,
+			"Node was not drained within %s, releasing ServerClaim %s", timeout, client.ObjectKeyFromObject(serverClaim))
+		return ctrl.Result{}, r.patchDrainFinalizer(ctx, serverClaim, controllerutil.RemoveFinalizer)
+	}
+
+	if !node.Spec.Unschedulable {
+		r.recorder.Eventf(node, serverClaim, corev1.EventTypeNormal, eventReasonDrainingNode, eventActionDrain,
+			"Draining Node because ServerClaim %s is being deleted", client.ObjectKeyFromObject(serverClaim))
+	}
+	drained, err := r.drainer.drain(ctx, node)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !drained {
+		return ctrl.Result{RequeueAfter: min(DrainRequeueDelay, remaining)}, nil
+	}
+
+	klog.InfoS("Drained Node, releasing ServerClaim", "Node", node.Name, "ServerClaim", client.ObjectKeyFromObject(serverClaim))
+	r.recorder.Eventf(node, serverClaim, corev1.EventTypeNormal, eventReasonNodeDrained, eventActionDrain,
+		"Node drained, releasing ServerClaim %s", client.ObjectKeyFromObject(serverClaim))
+	return ctrl.Result{}, r.patchDrainFinalizer(ctx, serverClaim, controllerutil.RemoveFinalizer)
+}
+
+// patchDrainFinalizer adds or removes the drain finalizer with the given function and patches the
+// ServerClaim if that changed it.
+func (r *ServerClaimReconciler) patchDrainFinalizer(ctx context.Context, serverClaim *metalv1alpha1.ServerClaim, mutate func(client.Object, string) bool) error {
+	base := serverClaim.DeepCopy()
+	if !mutate(serverClaim, serverClaimDrainFinalizer) {
+		return nil
+	}
+	if err := r.metalClient.Patch(ctx, serverClaim, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("failed to patch finalizers of ServerClaim %s: %w", client.ObjectKeyFromObject(serverClaim), err)
+	}
+	return nil
 }
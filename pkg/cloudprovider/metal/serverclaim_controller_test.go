@@ -4,6 +4,8 @@
 package metal
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -11,6 +13,7 @@ import (
 
 	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -122,6 +125,10 @@ var _ = Describe("ServerClaimReconciler", func() {
 	})
 
 	It("should remove the maintenance needed label when not needed", func(ctx SpecContext) {
+		// Labelling the ServerClaim with the cluster name triggers the reconciler, so the Node label is
+		// only set once that happened.
+		Eventually(Object(serverClaim)).Should(HaveField("Labels", HaveKey(LabelKeyClusterName)))
+
 		originalNode := node.DeepCopy()
 		node.Labels = map[string]string{
 			metalv1alpha1.ServerMaintenanceNeededLabelKey: TrueStr,
@@ -138,3 +145,155 @@ var _ = Describe("ServerClaimReconciler", func() {
 		Consistently(Object(node)).Should(HaveField("Labels", BeEmpty()))
 	})
 })
+
+var _ = Describe("ServerClaimReconciler with propagation", func() {
+	ns, _, _ := SetupTest(CloudConfig{
+		ClusterName: "test-cluster",
+		Propagation: Propagation{
+			Labels:      []string{"example.com/hardware-degraded", "reservation.example.com/*"},
+			Annotations: []string{"example.com/decommission-date", AnnotationPowerOff, AnnotationBIOSVersion},
+		},
+	})
+
+	It("should mirror the selected keys of the ServerClaim onto the Node", func(ctx SpecContext) {
+		_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+
+		By("Setting a label on the Node that is also selected for propagation")
+		Eventually(Update(node, func() {
+			node.Labels = map[string]string{"reservation.example.com/team": "tenant"}
+		})).Should(Succeed())
+
+		By("Labeling and annotating the ServerClaim")
+		Eventually(Update(serverClaim, func() {
+			serverClaim.Labels = map[string]string{
+				"example.com/hardware-degraded": TrueStr,
+				"reservation.example.com/owner": "team-a",
+				"example.com/unselected":        "a",
+			}
+			serverClaim.Annotations = map[string]string{
+				"example.com/decommission-date": "2027-01-01",
+				AnnotationPowerOff:              TrueStr,
+				AnnotationBIOSVersion:           "2.0",
+			}
+		})).Should(Succeed())
+
+		Eventually(Object(node)).Should(SatisfyAll(
+			HaveField("Labels", SatisfyAll(
+				HaveKeyWithValue("example.com/hardware-degraded", TrueStr),
+				HaveKeyWithValue("reservation.example.com/owner", "team-a"),
+				HaveKeyWithValue("reservation.example.com/team", "tenant"),
+				Not(HaveKey("example.com/unselected")),
+			)),
+			HaveField("Annotations", SatisfyAll(
+				HaveKeyWithValue("example.com/decommission-date", "2027-01-01"),
+				HaveKeyWithValue(AnnotationPropagatedLabels, "example.com/hardware-degraded,reservation.example.com/owner"),
+				HaveKeyWithValue(AnnotationPropagatedAnnotations, "example.com/decommission-date"),
+				Not(HaveKey(AnnotationPowerOff)),
+				Not(HaveKey(AnnotationBIOSVersion)),
+			)),
+		))
+
+		By("Removing the keys from the ServerClaim")
+		Eventually(Update(serverClaim, func() {
+			serverClaim.Labels = map[string]string{"reservation.example.com/owner": "team-a"}
+			serverClaim.Annotations = nil
+		})).Should(Succeed())
+
+		Eventually(Object(node)).Should(SatisfyAll(
+			HaveField("Labels", SatisfyAll(
+				Not(HaveKey("example.com/hardware-degraded")),
+				HaveKeyWithValue("reservation.example.com/owner", "team-a"),
+				HaveKeyWithValue("reservation.example.com/team", "tenant"),
+			)),
+			HaveField("Annotations", SatisfyAll(
+				Not(HaveKey("example.com/decommission-date")),
+				HaveKeyWithValue(AnnotationPropagatedLabels, "reservation.example.com/owner"),
+				Not(HaveKey(AnnotationPropagatedAnnotations)),
+			)),
+		))
+	})
+
+	It("should not mirror the keys of a ServerClaim owned by another cluster", func(ctx SpecContext) {
+		_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+
+		Eventually(Update(serverClaim, func() {
+			serverClaim.Labels = map[string]string{
+				LabelKeyClusterName:             "other-cluster",
+				"example.com/hardware-degraded": TrueStr,
+			}
+		})).Should(Succeed())
+		Consistently(Object(node)).Should(HaveField("Labels", Not(HaveKey("example.com/hardware-degraded"))))
+	})
+})
+
+var _ = Describe("ServerClaimReconciler with ServerClaim protection", func() {
+	ns, _, _ := SetupTest(CloudConfig{
+		ClusterName: "test-cluster",
+		ServerClaimProtection: ServerClaimProtection{
+			Enabled:      true,
+			DrainTimeout: metav1.Duration{Duration: 3 * time.Second},
+		},
+	})
+
+	It("should drain the Node before releasing the ServerClaim", func(ctx SpecContext) {
+		_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+
+		By("Ensuring the ServerClaim is protected")
+		Eventually(Object(serverClaim)).Should(HaveField("Finalizers", ContainElement(serverClaimDrainFinalizer)))
+
+		By("Deleting the ServerClaim")
+		Expect(k8sClient.Delete(ctx, serverClaim)).To(Succeed())
+
+		By("Ensuring the Node is cordoned and the ServerClaim is released")
+		Eventually(Object(node)).Should(HaveField("Spec.Unschedulable", BeTrue()))
+		Eventually(Get(serverClaim)).Should(MatchError(apierrors.IsNotFound, "IsNotFound"))
+	})
+
+	It("should release the ServerClaim after the drain timeout", func(ctx SpecContext) {
+		_, serverClaim, node := createRegisteredNode(ctx, ns.Name)
+		Eventually(Object(serverClaim)).Should(HaveField("Finalizers", ContainElement(serverClaimDrainFinalizer)))
+
+		By("Creating a pod on the Node")
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-",
+			},
+			Spec: corev1.PodSpec{
+				NodeName:   node.Name,
+				Containers: []corev1.Container{{Name: "test", Image: "test"}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, pod)).To(Succeed())
+		DeferCleanup(func(ctx SpecContext) error {
+			return client.IgnoreNotFound(k8sClient.Delete(ctx, pod, client.GracePeriodSeconds(0)))
+		})
+
+		By("Deleting the ServerClaim")
+		Expect(k8sClient.Delete(ctx, serverClaim)).To(Succeed())
+
+		By("Ensuring the pod is evicted")
+		Eventually(Object(node)).Should(HaveField("Spec.Unschedulable", BeTrue()))
+		Eventually(Object(pod)).Should(HaveField("DeletionTimestamp", Not(BeNil())))
+
+		By("Ensuring the ServerClaim is released once the drain timed out")
+		// The drain timeout starts with the deletion, so the claim is only released after it on top of the usual delay.
+		Eventually(Get(serverClaim)).WithTimeout(3*time.Second + eventuallyTimeout).Should(MatchError(apierrors.IsNotFound, "IsNotFound"))
+	})
+
+	It("should not protect a ServerClaim without a Node", func(ctx SpecContext) {
+		serverClaim := &metalv1alpha1.ServerClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-",
+			},
+			Spec: metalv1alpha1.ServerClaimSpec{
+				Power: "On",
+			},
+		}
+		Expect(k8sClient.Create(ctx, serverClaim)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, serverClaim)
+
+		Consistently(Object(serverClaim)).Should(HaveField("Finalizers", BeEmpty()))
+	})
+})
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+// serverConditionPoweringOn is the Server condition the metal-operator sets while a server powers on.
+const serverConditionPoweringOn = "PoweringOn"
+
+// isServerShutDown decides whether the Server of a ServerClaim counts as shut down for the node
+// lifecycle controller. It combines the power requested on the ServerClaim with the power state,
+// state and conditions reported by the Server and returns a short reason for the decision.
+func isServerShutDown(serverClaim *metalv1alpha1.ServerClaim, server *metalv1alpha1.Server, shutdown Shutdown) (bool, string) {
+	inMaintenance := server.Status.State == metalv1alpha1.ServerStateMaintenance || server.Spec.ServerMaintenanceRef != nil
+	if inMaintenance && shutdown.MaintenanceIsShutdown {
+		return true, "server is held in maintenance"
+	}
+
+	switch server.Status.PowerState {
+	case metalv1alpha1.ServerOffPowerState:
+		return true, "server is powered off"
+	case metalv1alpha1.ServerPoweringOffPowerState:
+		return true, "server is powering off"
+	case metalv1alpha1.ServerOnPowerState:
+		return false, "server is powered on"
+	case metalv1alpha1.ServerPoweringOnPowerState:
+		return false, "server is powering on"
+	}
+	if meta.IsStatusConditionTrue(server.Status.Conditions, serverConditionPoweringOn) {
+		return false, "server is powering on"
+	}
+
+	// The power state is paused or unknown, so the intent and the state of the server decide.
+	if serverClaim.Spec.Power == metalv1alpha1.PowerOff {
+		return true, "server claim requests power off"
+	}
+	if server.Status.State == metalv1alpha1.ServerStateError {
+		return true, "server is in error state without a known power state"
+	}
+	if server.Status.PowerState == metalv1alpha1.ServerPausedPowerState {
+		return true, "server is paused"
+	}
+	return false, "server power state is unknown"
+}
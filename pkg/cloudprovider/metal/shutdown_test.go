@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("isServerShutDown", func() {
+	DescribeTable("should combine the power intent with the server state",
+		func(claimPower metalv1alpha1.Power, status metalv1alpha1.ServerStatus, shutdown Shutdown, expected bool) {
+			serverClaim := &metalv1alpha1.ServerClaim{
+				Spec: metalv1alpha1.ServerClaimSpec{Power: claimPower},
+			}
+			server := &metalv1alpha1.Server{Status: status}
+			shutDown, _ := isServerShutDown(serverClaim, server, shutdown)
+			Expect(shutDown).To(Equal(expected))
+		},
+		Entry("powered on", metalv1alpha1.PowerOn,
+			metalv1alpha1.ServerStatus{PowerState: metalv1alpha1.ServerOnPowerState}, Shutdown{}, false),
+		Entry("powered off", metalv1alpha1.PowerOn,
+			metalv1alpha1.ServerStatus{PowerState: metalv1alpha1.ServerOffPowerState}, Shutdown{}, true),
+		Entry("powering off", metalv1alpha1.PowerOff,
+			metalv1alpha1.ServerStatus{PowerState: metalv1alpha1.ServerPoweringOffPowerState}, Shutdown{}, true),
+		Entry("powering on", metalv1alpha1.PowerOn,
+			metalv1alpha1.ServerStatus{PowerState: metalv1alpha1.ServerPoweringOnPowerState}, Shutdown{}, false),
+		Entry("power off requested but still running", metalv1alpha1.PowerOff,
+			metalv1alpha1.ServerStatus{PowerState: metalv1alpha1.ServerOnPowerState}, Shutdown{}, false),
+		Entry("power off requested with an unknown power state", metalv1alpha1.PowerOff,
+			metalv1alpha1.ServerStatus{}, Shutdown{}, true),
+		Entry("unknown power state with a powering on condition", metalv1alpha1.PowerOff,
+			metalv1alpha1.ServerStatus{Conditions: []metav1.Condition{{Type: serverConditionPoweringOn, Status: metav1.ConditionTrue}}}, Shutdown{}, false),
+		Entry("error state with an unknown power state", metalv1alpha1.PowerOn,
+			metalv1alpha1.ServerStatus{State: metalv1alpha1.ServerStateError}, Shutdown{}, true),
+		Entry("error state while powered on", metalv1alpha1.PowerOn,
+			metalv1alpha1.ServerStatus{State: metalv1alpha1.ServerStateError, PowerState: metalv1alpha1.ServerOnPowerState}, Shutdown{}, false),
+		Entry("paused", metalv1alpha1.PowerOn,
+			metalv1alpha1.ServerStatus{PowerState: metalv1alpha1.ServerPausedPowerState}, Shutdown{}, true),
+		Entry("unknown power state", metalv1alpha1.PowerOn,
+			metalv1alpha1.ServerStatus{}, Shutdown{}, false),
+		Entry("in maintenance while powered on", metalv1alpha1.PowerOn,
+			metalv1alpha1.ServerStatus{State: metalv1alpha1.ServerStateMaintenance, PowerState: metalv1alpha1.ServerOnPowerState}, Shutdown{}, false),
+		Entry("in maintenance counting as shut down", metalv1alpha1.PowerOn,
+			metalv1alpha1.ServerStatus{State: metalv1alpha1.ServerStateMaintenance, PowerState: metalv1alpha1.ServerOnPowerState}, Shutdown{MaintenanceIsShutdown: true}, true),
+	)
+})
@@ -12,11 +12,13 @@ import (
 	"testing"
 	"time"
 
+	infov1alpha1 "github.com/ironcore-dev/cloud-provider-metal/api/v1alpha1"
 	"github.com/ironcore-dev/controller-utils/modutils"
 	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -63,6 +65,7 @@ var _ = BeforeSuite(func() {
 	testEnv = &envtest.Environment{
 		CRDDirectoryPaths: []string{
 			modutils.Dir("github.com/ironcore-dev/metal-operator", "config", "crd", "bases"),
+			filepath.Join("..", "..", "..", "config", "crd", "bases"),
 		},
 		ErrorIfCRDPathMissing: true,
 
@@ -85,6 +88,7 @@ var _ = BeforeSuite(func() {
 
 	Expect(metalv1alpha1.AddToScheme(scheme.Scheme)).To(Succeed())
 	Expect(capiv1beta1.AddToScheme(scheme.Scheme)).To(Succeed())
+	Expect(infov1alpha1.AddToScheme(scheme.Scheme)).To(Succeed())
 
 	k8sClient, err = client.New(cfg, client.Options{Scheme: scheme.Scheme})
 	Expect(err).NotTo(HaveOccurred())
@@ -159,3 +163,63 @@ func SetupTest(cloudConfig CloudConfig) (*corev1.Namespace, *cloudprovider.Inter
 
 	return ns, &cp, cloudConfig.ClusterName
 }
+
+// createRegisteredNode creates a powered on Server, a ServerClaim bound to it and a Node that
+// carries the providerID of the ServerClaim.
+func createRegisteredNode(ctx context.Context, namespace string) (*metalv1alpha1.Server, *metalv1alpha1.ServerClaim, *corev1.Node) {
+	By("Creating a Server")
+	server := &metalv1alpha1.Server{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "test-",
+		},
+		Spec: metalv1alpha1.ServerSpec{
+			SystemUUID: "12345",
+			Power:      "On",
+		},
+	}
+	Expect(k8sClient.Create(ctx, server)).To(Succeed())
+	DeferCleanup(k8sClient.Delete, server)
+
+	By("Patching the Server object to be powered on")
+	Eventually(UpdateStatus(server, func() {
+		server.Status.PowerState = metalv1alpha1.ServerOnPowerState
+	})).Should(Succeed())
+
+	By("Creating a ServerClaim for a Node")
+	serverClaim := &metalv1alpha1.ServerClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    namespace,
+			GenerateName: "test-",
+		},
+		Spec: metalv1alpha1.ServerClaimSpec{
+			Power:     "On",
+			ServerRef: &corev1.LocalObjectReference{Name: server.Name},
+		},
+	}
+	Expect(k8sClient.Create(ctx, serverClaim)).To(Succeed())
+	DeferCleanup(func(ctx SpecContext) error {
+		return client.IgnoreNotFound(k8sClient.Delete(ctx, serverClaim))
+	})
+
+	By("Creating a Node object with a provider ID referencing the ServerClaim")
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "test-",
+		},
+		Spec: corev1.NodeSpec{
+			ProviderID: buildProviderID(serverClaim.Namespace, serverClaim.Name),
+		},
+	}
+	Expect(k8sClient.Create(ctx, node)).To(Succeed())
+	DeferCleanup(func(ctx SpecContext) {
+		Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, node))).To(Succeed())
+		Eventually(Get(node)).Should(MatchError(apierrors.IsNotFound, "IsNotFound"))
+	})
+
+	By("Updating the SystemUUID in Node status")
+	Eventually(UpdateStatus(node, func() {
+		node.Status.NodeInfo.SystemUUID = server.Spec.SystemUUID
+	})).Should(Succeed())
+
+	return server, serverClaim, node
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	"slices"
+	"strings"
+
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog/v2"
+)
+
+// getTopologyLabels returns the switch, rack and pod labels of a Server. Values taken from the
+// configured Server labels take precedence over the switch derived from the LLDP neighbours.
+func getTopologyLabels(topology Topology, server *metalv1alpha1.Server) map[string]string {
+	labels := make(map[string]string)
+	setLabel := func(labelKey, value string) {
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			klog.V(2).InfoS("Skipping invalid topology label value", "Server", server.Name, "Label", labelKey, "Value", value, "Errors", errs)
+			return
+		}
+		labels[labelKey] = value
+	}
+
+	if topology.SwitchFromNeighbors {
+		if switchName := getNeighborSwitchName(server, topology.NeighborInterface); switchName != "" {
+			setLabel(LabelKeyTopologySwitch, switchName)
+		}
+	}
+
+	for labelKey, serverLabelKey := range map[string]string{
+		LabelKeyTopologySwitch: topology.ServerLabels.Switch,
+		LabelKeyTopologyRack:   topology.ServerLabels.Rack,
+		LabelKeyTopologyPod:    topology.ServerLabels.Pod,
+	} {
+		if serverLabelKey == "" {
+			continue
+		}
+		if value, ok := server.Labels[serverLabelKey]; ok {
+			setLabel(labelKey, value)
+		}
+	}
+	return labels
+}
+
+// getNeighborSwitchName returns the system name of the first LLDP neighbour found on the network
+// interfaces of a Server. Interfaces are visited in name order to keep the result stable.
+func getNeighborSwitchName(server *metalv1alpha1.Server, interfaceName string) string {
+	interfaces := slices.Clone(server.Status.NetworkInterfaces)
+	slices.SortFunc(interfaces, func(a, b metalv1alpha1.NetworkInterface) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	for _, iface := range interfaces {
+		if interfaceName != "" && iface.Name != interfaceName {
+			continue
+		}
+		for _, neighbor := range iface.Neighbors {
+			if neighbor.SystemName != "" {
+				return neighbor.SystemName
+			}
+		}
+	}
+	return ""
+}
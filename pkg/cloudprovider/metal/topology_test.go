@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metal
+
+import (
+	metalv1alpha1 "github.com/ironcore-dev/metal-operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("getTopologyLabels", func() {
+	server := &metalv1alpha1.Server{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "server",
+			Labels: map[string]string{
+				"example.org/rack":   "r12",
+				"example.org/pod":    "p1",
+				"example.org/switch": "tor-from-label",
+				"example.org/bad":    "not a valid/label value",
+			},
+		},
+		Status: metalv1alpha1.ServerStatus{
+			NetworkInterfaces: []metalv1alpha1.NetworkInterface{
+				{
+					Name:      "eth1",
+					Neighbors: []metalv1alpha1.LLDPNeighbor{{SystemName: "tor-b", PortID: "Ethernet1/2"}},
+				},
+				{
+					Name:      "eth0",
+					Neighbors: []metalv1alpha1.LLDPNeighbor{{SystemName: "tor-a", PortID: "Ethernet1/1"}},
+				},
+			},
+		},
+	}
+
+	DescribeTable("should derive topology labels from the server",
+		func(topology Topology, expected map[string]string) {
+			Expect(getTopologyLabels(topology, server)).To(Equal(expected))
+		},
+		Entry("disabled", Topology{}, map[string]string{}),
+		Entry("switch from the first interface with a neighbour",
+			Topology{SwitchFromNeighbors: true},
+			map[string]string{LabelKeyTopologySwitch: "tor-a"}),
+		Entry("switch from a configured interface",
+			Topology{SwitchFromNeighbors: true, NeighborInterface: "eth1"},
+			map[string]string{LabelKeyTopologySwitch: "tor-b"}),
+		Entry("switch from an interface without neighbours",
+			Topology{SwitchFromNeighbors: true, NeighborInterface: "eth2"},
+			map[string]string{}),
+		Entry("rack and pod from server labels",
+			Topology{ServerLabels: TopologyLabels{Rack: "example.org/rack", Pod: "example.org/pod"}},
+			map[string]string{LabelKeyTopologyRack: "r12", LabelKeyTopologyPod: "p1"}),
+		Entry("server label takes precedence over neighbours",
+			Topology{SwitchFromNeighbors: true, ServerLabels: TopologyLabels{Switch: "example.org/switch"}},
+			map[string]string{LabelKeyTopologySwitch: "tor-from-label"}),
+		Entry("missing server label",
+			Topology{ServerLabels: TopologyLabels{Rack: "example.org/missing"}},
+			map[string]string{}),
+		Entry("invalid label value",
+			Topology{ServerLabels: TopologyLabels{Rack: "example.org/bad"}},
+			map[string]string{}),
+		Entry("invalid server label keeps the switch from neighbours",
+			Topology{SwitchFromNeighbors: true, ServerLabels: TopologyLabels{Switch: "example.org/bad", Rack: "example.org/rack"}},
+			map[string]string{LabelKeyTopologySwitch: "tor-a", LabelKeyTopologyRack: "r12"}),
+	)
+})